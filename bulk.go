@@ -0,0 +1,92 @@
+package textmagic
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// WaitOptions configures how WaitForBulk polls a bulk session.
+type WaitOptions struct {
+	MinInterval time.Duration // Delay before the first poll. Default is 1s.
+	MaxInterval time.Duration // Upper bound of the delay between polls. Default is 30s.
+
+	// Progress, if set, is called with the bulk session after
+	// each poll, including the final one.
+	Progress func(*BulkSession)
+
+	// Events, if set, receives the bulk session after each poll.
+	// Sends block until received or the context is done, and the
+	// channel is not closed by WaitForBulk.
+	Events chan<- *BulkSession
+}
+
+// Progress returns the share of processed items, from 0 to 1.
+func (b *BulkSession) Progress() float64 {
+	if b.ItemsTotal <= 0 {
+		return 0
+	}
+
+	return float64(b.ItemsProcessed) / float64(b.ItemsTotal)
+}
+
+// WaitForBulk polls the bulk session with the given ID until it
+// reaches a final status, returning its message session. Polling
+// backs off exponentially while the session makes no progress.
+//
+// A failed bulk session returns an error wrapping ErrBulkFailed.
+// Suspended sessions are waited for until ctx is done. opts may
+// be nil to use the defaults.
+func (c *Client) WaitForBulk(ctx context.Context, id int, opts *WaitOptions) (*Session, error) {
+	if opts == nil {
+		opts = &WaitOptions{}
+	}
+
+	policy := RetryPolicy{MinBackoff: opts.MinInterval, MaxBackoff: opts.MaxInterval}
+
+	if policy.MinBackoff <= 0 {
+		policy.MinBackoff = time.Second
+	}
+
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = 30 * time.Second
+	}
+
+	processed := -1
+
+	for attempt := 1; ; attempt++ {
+		b, err := c.GetBulkSessionContext(ctx, id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if opts.Progress != nil {
+			opts.Progress(b)
+		}
+
+		if opts.Events != nil {
+			select {
+			case opts.Events <- b:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		if b.Status == BulkFailed {
+			return b.Session, fmt.Errorf("textmagic: bulk session %d: %w", id, ErrBulkFailed)
+		} else if b.Status.IsFinal() {
+			return b.Session, nil
+		}
+
+		// Poll quickly again while items are being processed
+		if b.ItemsProcessed != processed {
+			processed = b.ItemsProcessed
+			attempt = 1
+		}
+
+		if err = sleep(ctx, policy.backoff(attempt)); err != nil {
+			return nil, err
+		}
+	}
+}
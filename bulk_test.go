@@ -0,0 +1,65 @@
+package textmagic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitForBulk(t *testing.T) {
+	var polls int
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+
+		switch {
+		case r.URL.Path == "/bulks/2":
+			w.Write([]byte(`{"id":2,"status":"f","itemsProcessed":0,"itemsTotal":10}`))
+
+		case polls < 3:
+			fmt.Fprintf(w, `{"id":1,"status":"p","itemsProcessed":%d,"itemsTotal":10}`, polls*5)
+
+		default:
+			w.Write([]byte(`{"id":1,"status":"c","itemsProcessed":10,"itemsTotal":10,"session":{"id":9}}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient("", "", WithBaseURL(srv.URL))
+
+	var progress []float64
+
+	events := make(chan *BulkSession, 3)
+
+	s, err := c.WaitForBulk(context.Background(), 1, &WaitOptions{
+		MinInterval: time.Millisecond,
+		MaxInterval: time.Millisecond,
+		Events:      events,
+		Progress: func(b *BulkSession) {
+			progress = append(progress, b.Progress())
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 9, s.ID)
+	assert.Equal(t, []float64{0.5, 1, 1}, progress)
+	assert.Equal(t, 3, len(events))
+
+	_, err = c.WaitForBulk(context.Background(), 2, nil)
+
+	assert.ErrorIs(t, err, ErrBulkFailed)
+
+	// Context cancellation stops polling
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = c.WaitForBulk(ctx, 1, nil)
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
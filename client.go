@@ -1,32 +1,66 @@
 package textmagic
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strconv"
 	"strings"
+	"time"
 )
 
-const baseURL = "https://rest.textmagic.com/api/v2"
+const (
+	baseURL   = "https://rest.textmagic.com/api/v2"
+	userAgent = "textmagic-rest-go"
 
-var (
-	httpClient = &http.Client{}        // Re-usable HTTP client
-	emptyData  = url.Values{}.Encode() // Cache empty data request
+	defaultTimeout = 30 * time.Second // HTTP client timeout of new clients
+
+	maxErrorBody    = 1 << 16 // Error response bytes read for decoding
+	maxErrorSnippet = 512     // Error response bytes kept when not JSON
 )
 
+var emptyData = url.Values{}.Encode() // Cache empty data request
+
 // Client represents a API client.
 type Client struct {
-	username string
-	token    string
-	baseURL  string
+	username   string
+	token      string
+	baseURL    string
+	userAgent  string
+	header     http.Header
+	httpClient *http.Client
+	retry      RetryPolicy
+
+	limiter       *RateLimiter
+	limitFailFast bool
+	jsonBody      bool
 }
 
 // NewClient creates returns a client for the given
-// username / token pair.
-func NewClient(username, token string) *Client {
-	return &Client{username, token, baseURL}
+// username / token pair. Options are applied in order.
+//
+// Requests time out after 30 seconds, unless changed with
+// WithTimeout or by passing an HTTP client to WithHTTPClient.
+func NewClient(username, token string, opts ...Option) *Client {
+	c := &Client{
+		username:   username,
+		token:      token,
+		baseURL:    baseURL,
+		userAgent:  userAgent,
+		header:     http.Header{},
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		retry:      DefaultRetryPolicy,
+	}
+
+	for _, o := range opts {
+		o(c)
+	}
+
+	return c
 }
 
 // SetBaseURL sets the API base URL.
@@ -37,86 +71,200 @@ func (c *Client) SetBaseURL(u string) {
 // Request makes an API request, automatically decoding
 // the JSON payload for responses returning objects.
 func (c *Client) Request(method, uri string, p, d Params, dst interface{}) error {
-	var payload *strings.Reader
+	return c.RequestContext(context.Background(), method, uri, p, d, dst)
+}
+
+// RequestContext is the context-aware form of Request. The
+// context is bound to the underlying HTTP request, so cancelling
+// it aborts the call.
+//
+// Each attempt first waits for the client's rate limiter, if any,
+// and failed requests are retried according to its RetryPolicy.
+func (c *Client) RequestContext(ctx context.Context, method, uri string, p, d Params, dst interface{}) error {
+	return c.request(ctx, method, uri, p, d, nil, dst)
+}
+
+// request makes an API request. The data payload d is sent as
+// form data, or as JSON when enabled for the client or context,
+// in which case v, if not nil, is marshalled in its place.
+func (c *Client) request(ctx context.Context, method, uri string, p, d Params, v interface{}, dst interface{}) error {
+	var (
+		body        = emptyData
+		contentType = "application/x-www-form-urlencoded"
+		retries     = c.retryable(ctx, method, d)
+	)
+
+	if v == nil && d != nil {
+		v = d
+	}
+
+	if v != nil && (c.jsonBody || ctx.Value(jsonBodyKey{}) != nil) {
+		b, err := json.Marshal(v)
+
+		if err != nil {
+			return err
+		}
 
-	if d != nil {
-		payload = strings.NewReader(d.encode())
-	} else {
-		payload = strings.NewReader(emptyData)
+		body = string(b)
+		contentType = "application/json"
+	} else if d != nil {
+		body = d.encode()
 	}
 
 	if p != nil {
 		uri += "?" + p.encode()
 	}
 
-	req, err := http.NewRequest(method, c.baseURL+"/"+uri, payload)
+	for attempt := 1; ; attempt++ {
+		var wait time.Duration
+
+		if err := c.limit(ctx); err != nil {
+			return err
+		}
+
+		resp, err := c.send(ctx, method, uri, body, contentType)
+
+		if err == nil && c.limiter != nil {
+			c.limiter.update(resp.Header)
+		}
+
+		if err != nil {
+			if !retries || attempt >= c.retry.MaxAttempts || ctx.Err() != nil {
+				return err
+			}
+		} else if !retries || attempt >= c.retry.MaxAttempts || !retryStatus(resp.StatusCode) {
+			return decode(method, resp, dst)
+		} else if wait = retryAfter(resp.Header); wait > c.retry.MaxBackoff {
+			// Longer waits are left to the caller
+			return decode(method, resp, dst)
+		} else {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if b := c.retry.backoff(attempt); b > wait {
+			wait = b
+		}
+
+		if err = sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// send issues a single HTTP request.
+func (c *Client) send(ctx context.Context, method, uri, body, contentType string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+"/"+uri, strings.NewReader(body))
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if method != "GET" && method != "HEAD" {
-		req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Add("Content-Type", contentType)
 	}
 
 	req.Header.Add("Accept-Charset", "utf-8")
 	req.Header.Add("Accept-Language", "en-us")
+	req.Header.Set("User-Agent", c.userAgent)
+
+	for k, v := range c.header {
+		req.Header[k] = v
+	}
+
+	if k, ok := ctx.Value(idempotencyKey{}).(string); ok {
+		req.Header.Set("Idempotency-Key", k)
+	}
+
 	// To avoid Header.Add key capitalization.
 	req.Header["X-TM-Username"] = []string{c.username}
 	req.Header["X-TM-Key"] = []string{c.token}
 
-	resp, err := httpClient.Do(req)
-
-	if err != nil {
-		return err
-	}
+	return c.httpClient.Do(req)
+}
 
+// decode handles the API response, closing its body. Successful
+// responses are decoded into dst, unless it is nil.
+func decode(method string, resp *http.Response, dst interface{}) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 && resp.StatusCode != 201 && resp.StatusCode != 204 {
-		var e *Error
-
-		if err = json.NewDecoder(resp.Body).Decode(&e); err != nil {
-			return err
-		}
-
-		return e
+		return responseError(resp)
 	} else if method == "DELETE" {
 		if resp.StatusCode != 204 {
 			return NewError(resp.StatusCode, "unexpected status code for DELETE")
 		}
 
 		return nil
-	} else if resp.StatusCode == 204 {
+	} else if resp.StatusCode == 204 || dst == nil {
+		// Callers without a destination ignore the body
 		return nil
 	}
 
 	return json.NewDecoder(resp.Body).Decode(dst)
 }
 
-func (c *Client) get(uri string, p, d Params, dst interface{}) error {
-	return c.Request("GET", uri, p, d, dst)
+// responseError builds the Error for a failed response. Bodies
+// which are empty or not JSON, such as proxy error pages, are kept
+// as a bounded snippet instead of failing to decode.
+func responseError(resp *http.Response) *Error {
+	var e *Error
+
+	b, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+
+	if json.Unmarshal(b, &e) != nil || e == nil {
+		e = &Error{Message: http.StatusText(resp.StatusCode)}
+
+		if len(b) > maxErrorSnippet {
+			b = b[:maxErrorSnippet]
+		}
+
+		e.Body = strings.ToValidUTF8(string(b), "")
+	}
+
+	if e.Code == 0 {
+		e.Code = resp.StatusCode
+	}
+
+	e.Status = resp.StatusCode
+	e.Header = resp.Header
+
+	if req := resp.Request; req != nil {
+		e.Method = req.Method
+		e.URI = req.URL.String()
+	}
+
+	return e
+}
+
+func (c *Client) get(ctx context.Context, uri string, p, d Params, dst interface{}) error {
+	return c.RequestContext(ctx, "GET", uri, p, d, dst)
 }
 
-func (c *Client) post(uri string, p, d Params, dst interface{}) error {
-	return c.Request("POST", uri, p, d, dst)
+func (c *Client) post(ctx context.Context, uri string, p, d Params, dst interface{}) error {
+	return c.RequestContext(ctx, "POST", uri, p, d, dst)
 }
 
-func (c *Client) put(uri string, p, d Params, dst interface{}) error {
-	return c.Request("PUT", uri, p, d, dst)
+func (c *Client) put(ctx context.Context, uri string, p, d Params, dst interface{}) error {
+	return c.RequestContext(ctx, "PUT", uri, p, d, dst)
 }
 
-func (c *Client) delete(uri string, p, d Params, dst interface{}) error {
-	return c.Request("DELETE", uri, p, d, nil)
+func (c *Client) delete(ctx context.Context, uri string, p, d Params, dst interface{}) error {
+	return c.RequestContext(ctx, "DELETE", uri, p, d, nil)
 }
 
 // Ping sends a ping request to the API to test credentials.
 func (c *Client) Ping() error {
+	return c.PingContext(context.Background())
+}
+
+// PingContext is the context-aware form of Ping.
+func (c *Client) PingContext(ctx context.Context) error {
 	var p *struct {
 		Ping string `json:"ping"`
 	}
 
-	if err := c.get("ping", nil, nil, &p); err != nil {
+	if err := c.get(ctx, "ping", nil, nil, &p); err != nil {
 		return err
 	} else if p == nil || p.Ping != "pong" {
 		return ErrPing
@@ -130,17 +278,50 @@ type Params map[string]string
 
 // NewParams creates and returns a Params struct
 // from the given KVP.
+//
+// Values of unsupported types are silently left out. Use
+// BuildParams, or Set, to be told about them instead.
 func NewParams(k string, v interface{}) Params {
-	p := Params{}
-	p.Set(k, v)
+	p, _ := BuildParams(k, v)
 
 	return p
 }
 
+// BuildParams is like NewParams, but returns
+// an error for values of unsupported types.
+func BuildParams(k string, v interface{}) (Params, error) {
+	p := Params{}
+
+	if err := p.Set(k, v); err != nil {
+		return p, err
+	}
+
+	return p, nil
+}
+
 // Set sets the entry for the given key, coercing
-// the value into a string.
-func (p Params) Set(k string, v interface{}) {
-	p[k] = toString(v)
+// the value into a string:
+// - bool as 1 or 0.
+// - Integers and floats in decimal notation.
+// - Slices as comma separated lists.
+// - time.Time and Time as Unix timestamp, or empty if zero.
+// - fmt.Stringer using its String method.
+// - Maps as nested entries named k[key], as used for
+// custom field payloads.
+//
+// Values of other types return an error, leaving p unchanged.
+func (p Params) Set(k string, v interface{}) error {
+	e := Params{}
+
+	if err := e.set(k, v); err != nil {
+		return err
+	}
+
+	for k, v := range e {
+		p[k] = v
+	}
+
+	return nil
 }
 
 // Del deletes the parameter item with the given key.
@@ -148,6 +329,16 @@ func (p Params) Del(k string) {
 	delete(p, k)
 }
 
+func (p Params) clone() Params {
+	c := make(Params, len(p))
+
+	for k, v := range p {
+		c[k] = v
+	}
+
+	return c
+}
+
 func (p Params) encode() string {
 	u := url.Values{}
 
@@ -158,22 +349,113 @@ func (p Params) encode() string {
 	return u.Encode()
 }
 
-func toString(v interface{}) string {
-	var s string
+// set sets the entries for the value v
+// of the parameter k.
+func (p Params) set(k string, v interface{}) error {
+	if s, ok := toString(v); ok {
+		p[k] = s
+
+		return nil
+	}
+
+	r := reflect.ValueOf(v)
+
+	switch r.Kind() {
+	case reflect.Pointer:
+		if r.IsNil() {
+			p[k] = ""
+
+			return nil
+		}
+
+		return p.set(k, r.Elem().Interface())
+
+	case reflect.Slice, reflect.Array:
+		s := make([]string, r.Len())
 
+		for i := range s {
+			var ok bool
+
+			if s[i], ok = toString(r.Index(i).Interface()); !ok {
+				return unsupportedParam(k, v)
+			}
+		}
+
+		p[k] = strings.Join(s, ",")
+
+		return nil
+
+	case reflect.Map:
+		if r.Type().Key().Kind() != reflect.String {
+			return unsupportedParam(k, v)
+		}
+
+		for i := r.MapRange(); i.Next(); {
+			if err := p.set(k+"["+i.Key().String()+"]", i.Value().Interface()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return unsupportedParam(k, v)
+}
+
+// toString coerces scalar values into a string.
+func toString(v interface{}) (string, bool) {
 	switch c := v.(type) {
+	case nil:
+		return "", true
+
 	case string:
-		s = c
+		return c, true
+
+	case bool:
+		if c {
+			return "1", true
+		}
+
+		return "0", true
 
-	case int:
-		s = strconv.Itoa(c)
+	case time.Time:
+		if c.IsZero() {
+			return "", true
+		}
+
+		return strconv.FormatInt(c.Unix(), 10), true
+
+	case Time:
+		return toString(c.Time)
+	}
 
-	case []int:
-		s = joinIntSlice(c)
+	r := reflect.ValueOf(v)
 
-	default:
-		s = fmt.Sprintf("%v", s)
+	// String types, such as MessageStatus, are sent
+	// as is rather than by their String method.
+	if r.Kind() == reflect.String {
+		return r.String(), true
+	} else if c, ok := v.(fmt.Stringer); ok {
+		return c.String(), true
 	}
 
-	return s
+	switch r.Kind() {
+	case reflect.Bool:
+		return toString(r.Bool())
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(r.Int(), 10), true
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(r.Uint(), 10), true
+
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(r.Float(), 'f', -1, r.Type().Bits()), true
+	}
+
+	return "", false
+}
+
+func unsupportedParam(k string, v interface{}) error {
+	return fmt.Errorf("textmagic: unsupported type %T for parameter %q", v, k)
 }
@@ -0,0 +1,112 @@
+package textmagic
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stringer struct{}
+
+func (stringer) String() string { return "str" }
+
+func TestParams(t *testing.T) {
+	p := Params{}
+
+	assert.Nil(t, p.Set("string", "a"))
+	assert.Nil(t, p.Set("int", 1))
+	assert.Nil(t, p.Set("int64", int64(1<<40)))
+	assert.Nil(t, p.Set("uint8", uint8(7)))
+	assert.Nil(t, p.Set("float", 0.045))
+	assert.Nil(t, p.Set("true", true))
+	assert.Nil(t, p.Set("false", false))
+	assert.Nil(t, p.Set("ints", []int{1, 2}))
+	assert.Nil(t, p.Set("int64s", []int64{3, 4}))
+	assert.Nil(t, p.Set("strings", []string{"a", "b"}))
+	assert.Nil(t, p.Set("time", time.Unix(1500000000, 0)))
+	assert.Nil(t, p.Set("apiTime", Time{Time: time.Unix(1500000001, 0)}))
+	assert.Nil(t, p.Set("zeroTime", time.Time{}))
+	assert.Nil(t, p.Set("zeroAPITime", Time{}))
+	assert.Nil(t, p.Set("stringer", stringer{}))
+	assert.Nil(t, p.Set("status", MessageDelivered))
+	assert.Nil(t, p.Set("customFields", map[string]interface{}{"1": "x", "2": 5}))
+
+	assert.Equal(t, Params{
+		"string":          "a",
+		"int":             "1",
+		"int64":           "1099511627776",
+		"uint8":           "7",
+		"float":           "0.045",
+		"true":            "1",
+		"false":           "0",
+		"ints":            "1,2",
+		"int64s":          "3,4",
+		"strings":         "a,b",
+		"time":            "1500000000",
+		"apiTime":         "1500000001",
+		"zeroTime":        "",
+		"zeroAPITime":     "",
+		"stringer":        "str",
+		"status":          "d",
+		"customFields[1]": "x",
+		"customFields[2]": "5",
+	}, p)
+
+	// Unsupported types
+
+	assert.NotNil(t, p.Set("struct", struct{}{}))
+	assert.NotNil(t, p.Set("map", map[int]string{1: "a"}))
+	assert.NotNil(t, p.Set("nested", map[string]interface{}{"a": struct{}{}}))
+	assert.NotContains(t, p, "struct")
+	assert.NotContains(t, p, "nested[a]")
+
+	// BuildParams reports what NewParams drops
+
+	_, err := BuildParams("struct", struct{}{})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, Params{}, NewParams("struct", struct{}{}))
+
+	b, err := BuildParams("ids", []int{1, 2})
+
+	assert.Nil(t, err)
+	assert.Equal(t, Params{"ids": "1,2"}, b)
+}
+
+func TestContext(t *testing.T) {
+	release := make(chan struct{})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	c := NewClient("", "", WithBaseURL(srv.URL))
+
+	// Cancellation aborts a blocked request
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	_, err := c.GetContactListContext(ctx, nil)
+
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// So does a deadline
+
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err = c.RequestContext(ctx, "GET", "ping", nil, nil, nil)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -1,6 +1,12 @@
 package textmagic
 
-import "strconv"
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"strconv"
+	"strings"
+)
 
 const contactURI = "contacts"
 
@@ -23,18 +29,119 @@ type Contact struct {
 
 // ContactList represents a contact list object,
 // including pagination and statistical information.
-type ContactList struct {
-	Page      int        `json:"page"`
-	Limit     int        `json:"limit"`
-	PageCount int        `json:"pageCount"`
-	Resources []*Contact `json:"resources"`
+type ContactList = Page[*Contact]
+
+// ContactInput represents the data of a contact, as
+// used by CreateContactFrom and UpdateContactFrom.
+type ContactInput struct {
+	FirstName string
+	LastName  string
+	Phone     string // Contact's phone number. Required.
+	Email     string
+	Company   string
+	Country   string // 2-letter ISO country code.
+	Lists     []int  // Lists to assign the contact to. Required.
+
+	CustomFields map[int]string // Custom field values by custom field ID.
+}
+
+// Validate checks the input for missing and
+// invalid fields, returning ValidationErrors.
+func (in *ContactInput) Validate() error {
+	v := ValidationErrors{}
+
+	if in.Phone == "" {
+		v.Add("phone", "phone is required")
+	}
+
+	if len(in.Lists) == 0 {
+		v.Add("lists", "at least one list is required")
+	}
+
+	if in.Country != "" && !ValidCountry(in.Country) {
+		v.Add("country", "country must be a 2-letter ISO country code")
+	}
+
+	if len(v) > 0 {
+		return v
+	}
+
+	return nil
+}
+
+// Params validates the input and encodes it
+// into the CreateContact data payload.
+func (in *ContactInput) Params() (Params, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
+	p := Params{
+		"phone": in.Phone,
+		"lists": joinIntSlice(in.Lists),
+	}
+
+	if in.FirstName != "" {
+		p["firstName"] = in.FirstName
+	}
+
+	if in.LastName != "" {
+		p["lastName"] = in.LastName
+	}
+
+	if in.Email != "" {
+		p["email"] = in.Email
+	}
+
+	if in.Company != "" {
+		p["companyName"] = in.Company
+	}
+
+	if in.Country != "" {
+		p["country"] = strings.ToUpper(in.Country)
+	}
+
+	for id, v := range in.CustomFields {
+		p["customFields["+strconv.Itoa(id)+"]"] = v
+	}
+
+	return p, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface,
+// encoding the input as a CreateContact JSON body.
+func (in *ContactInput) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		FirstName    string         `json:"firstName,omitempty"`
+		LastName     string         `json:"lastName,omitempty"`
+		Phone        string         `json:"phone"`
+		Email        string         `json:"email,omitempty"`
+		Company      string         `json:"companyName,omitempty"`
+		Country      string         `json:"country,omitempty"`
+		Lists        string         `json:"lists"`
+		CustomFields map[int]string `json:"customFields,omitempty"`
+	}{
+		FirstName:    in.FirstName,
+		LastName:     in.LastName,
+		Phone:        in.Phone,
+		Email:        in.Email,
+		Company:      in.Company,
+		Country:      strings.ToUpper(in.Country),
+		Lists:        joinIntSlice(in.Lists),
+		CustomFields: in.CustomFields,
+	})
 }
 
 // GetContact returns a single contact by ID.
 func (c *Client) GetContact(id int) (*Contact, error) {
+	return c.GetContactContext(context.Background(), id)
+}
+
+// GetContactContext is the context-aware form of GetContact.
+func (c *Client) GetContactContext(ctx context.Context, id int) (*Contact, error) {
 	var contact *Contact
 
-	return contact, c.get(contactURI+"/"+strconv.Itoa(id), nil, nil, &contact)
+	return contact, c.get(ctx, contactURI+"/"+strconv.Itoa(id), nil, nil, &contact)
 }
 
 // CreateContact creates a new contact with
@@ -49,9 +156,33 @@ func (c *Client) GetContact(id int) (*Contact, error) {
 // - country:       2-letter ISO country code.
 // - lists:         String of Lists separated by commas to assign contact. Required.
 func (c *Client) CreateContact(d Params) (*NewContact, error) {
+	return c.CreateContactContext(context.Background(), d)
+}
+
+// CreateContactContext is the context-aware form of CreateContact.
+func (c *Client) CreateContactContext(ctx context.Context, d Params) (*NewContact, error) {
 	var contact *NewContact
 
-	return contact, c.post(contactURI, nil, d, &contact)
+	return contact, c.post(ctx, contactURI, nil, d, &contact)
+}
+
+// CreateContactFrom is like CreateContact, but takes a typed
+// input which is validated before it is sent.
+func (c *Client) CreateContactFrom(in *ContactInput) (*NewContact, error) {
+	return c.CreateContactFromContext(context.Background(), in)
+}
+
+// CreateContactFromContext is the context-aware form of CreateContactFrom.
+func (c *Client) CreateContactFromContext(ctx context.Context, in *ContactInput) (*NewContact, error) {
+	d, err := in.Params()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var contact *NewContact
+
+	return contact, c.request(ctx, "POST", contactURI, nil, d, in, &contact)
 }
 
 // GetContactList returns the contact list.
@@ -61,9 +192,25 @@ func (c *Client) CreateContact(d Params) (*NewContact, error) {
 // - limit:     How many results on page.
 // - shared:    Should shared contacts to be included.
 func (c *Client) GetContactList(p Params) (*ContactList, error) {
+	return c.GetContactListContext(context.Background(), p)
+}
+
+// GetContactListContext is the context-aware form of GetContactList.
+func (c *Client) GetContactListContext(ctx context.Context, p Params) (*ContactList, error) {
 	var l *ContactList
 
-	return l, c.get(contactURI, p, nil, &l)
+	return l, c.get(ctx, contactURI, p, nil, &l)
+}
+
+// AllContacts returns an iterator over all contacts.
+func (c *Client) AllContacts(ctx context.Context, p Params) iter.Seq2[*Contact, error] {
+	return Paginate(ctx, p, c.GetContactListContext)
+}
+
+// WalkContacts is like AllContacts, but fetches up to
+// workers pages concurrently. See WalkPages.
+func (c *Client) WalkContacts(ctx context.Context, p Params, workers int) iter.Seq2[*Contact, error] {
+	return WalkPages(ctx, p, workers, c.GetContactListContext)
 }
 
 // SearchContactList returns a contact list in relation
@@ -77,9 +224,20 @@ func (c *Client) GetContactList(p Params) (*ContactList, error) {
 // - listId:    Find contact by List ID.
 // - query:     Find contact by specified search query.
 func (c *Client) SearchContactList(p Params) (*ContactList, error) {
+	return c.SearchContactListContext(context.Background(), p)
+}
+
+// SearchContactListContext is the context-aware form of SearchContactList.
+func (c *Client) SearchContactListContext(ctx context.Context, p Params) (*ContactList, error) {
 	var l *ContactList
 
-	return l, c.get(contactURI+"/search", p, nil, &l)
+	return l, c.get(ctx, contactURI+"/search", p, nil, &l)
+}
+
+// SearchAllContacts returns an iterator over all contacts
+// in relation to search filters.
+func (c *Client) SearchAllContacts(ctx context.Context, p Params) iter.Seq2[*Contact, error] {
+	return Paginate(ctx, p, c.SearchContactListContext)
 }
 
 // UpdateContact updates an existing contact with
@@ -94,15 +252,44 @@ func (c *Client) SearchContactList(p Params) (*ContactList, error) {
 // - country:		2-letter ISO country code.
 // - lists:         String of Lists separated by commas to assign contact. Required.
 func (c *Client) UpdateContact(id int, d Params) (*NewContact, error) {
+	return c.UpdateContactContext(context.Background(), id, d)
+}
+
+// UpdateContactContext is the context-aware form of UpdateContact.
+func (c *Client) UpdateContactContext(ctx context.Context, id int, d Params) (*NewContact, error) {
 	var contact *NewContact
 
-	return contact, c.put(contactURI+"/"+strconv.Itoa(id), nil, d, &contact)
+	return contact, c.put(ctx, contactURI+"/"+strconv.Itoa(id), nil, d, &contact)
+}
+
+// UpdateContactFrom is like UpdateContact, but takes a typed
+// input which is validated before it is sent.
+func (c *Client) UpdateContactFrom(id int, in *ContactInput) (*NewContact, error) {
+	return c.UpdateContactFromContext(context.Background(), id, in)
+}
+
+// UpdateContactFromContext is the context-aware form of UpdateContactFrom.
+func (c *Client) UpdateContactFromContext(ctx context.Context, id int, in *ContactInput) (*NewContact, error) {
+	d, err := in.Params()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var contact *NewContact
+
+	return contact, c.request(ctx, "PUT", contactURI+"/"+strconv.Itoa(id), nil, d, in, &contact)
 }
 
 // DeleteContact deletes the contact with
 // the given ID.
 func (c *Client) DeleteContact(id int) error {
-	return c.delete(contactURI+"/"+strconv.Itoa(id), nil, nil, nil)
+	return c.DeleteContactContext(context.Background(), id)
+}
+
+// DeleteContactContext is the context-aware form of DeleteContact.
+func (c *Client) DeleteContactContext(ctx context.Context, id int) error {
+	return c.delete(ctx, contactURI+"/"+strconv.Itoa(id), nil, nil, nil)
 }
 
 // GetContactLists returns the lists the given
@@ -112,7 +299,20 @@ func (c *Client) DeleteContact(id int) error {
 // - page:	Fetch specified results page.
 // - limit:	How many results on page.
 func (c *Client) GetContactLists(id int, p Params) (*Lists, error) {
+	return c.GetContactListsContext(context.Background(), id, p)
+}
+
+// GetContactListsContext is the context-aware form of GetContactLists.
+func (c *Client) GetContactListsContext(ctx context.Context, id int, p Params) (*Lists, error) {
 	var l *Lists
 
-	return l, c.get(contactURI+"/"+strconv.Itoa(id)+"/lists", p, nil, &l)
+	return l, c.get(ctx, contactURI+"/"+strconv.Itoa(id)+"/lists", p, nil, &l)
+}
+
+// AllContactLists returns an iterator over all lists
+// the given contact belongs to.
+func (c *Client) AllContactLists(ctx context.Context, id int, p Params) iter.Seq2[*List, error] {
+	return Paginate(ctx, p, func(ctx context.Context, p Params) (*Page[*List], error) {
+		return c.GetContactListsContext(ctx, id, p)
+	})
 }
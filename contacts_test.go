@@ -227,3 +227,38 @@ func TestContacts(t *testing.T) {
 	err = client.DeleteList(secList.ID)
 	assert.Nil(t, err)
 }
+
+func TestContactInput(t *testing.T) {
+	in := &ContactInput{
+		FirstName: "John",
+		Phone:     "447860021130",
+		Country:   "gb",
+		Lists:     []int{1, 2},
+	}
+
+	p, err := in.Params()
+
+	assert.Nil(t, err)
+	assert.Equal(t, Params{
+		"firstName": "John",
+		"phone":     "447860021130",
+		"country":   "GB",
+		"lists":     "1,2",
+	}, p)
+
+	// Validation
+
+	in = &ContactInput{Country: "XX"}
+
+	_, err = in.Params()
+
+	assert.Equal(t, ValidationErrors{
+		"phone":   {"phone is required"},
+		"lists":   {"at least one list is required"},
+		"country": {"country must be a 2-letter ISO country code"},
+	}, err)
+
+	_, err = (&ListInput{}).Params()
+
+	assert.Equal(t, ValidationErrors{"name": {"name is required"}}, err)
+}
@@ -1,6 +1,10 @@
 package textmagic
 
-import "strconv"
+import (
+	"context"
+	"iter"
+	"strconv"
+)
 
 const customFieldURI = "customfields"
 
@@ -14,38 +18,80 @@ type NewCustomField struct {
 type CustomField struct {
 	ID        int    `json:"id"`
 	Name      string `json:"name"`
-	CreatedAt string `json:"createdAt"`
+	CreatedAt Time   `json:"createdAt"`
 }
 
 // ContactCustomField represents a contact custom field.
 type ContactCustomField struct {
 	ID        int    `json:"id"`
 	Name      string `json:"name"`
-	CreatedAt string `json:"createdAt"`
+	CreatedAt Time   `json:"createdAt"`
 	Value     string `json:"value"`
 }
 
 // CustomFieldList represents a custom field list.
-type CustomFieldList struct {
-	Page      int            `json:"page"`
-	Limit     int            `json:"limit"`
-	PageCount int            `json:"pageCount"`
-	Resources []*CustomField `json:"resources"`
+type CustomFieldList = Page[*CustomField]
+
+// CustomFieldValueInput represents a contact's custom
+// field value, as used by UpdateCustomFieldValueFrom.
+type CustomFieldValueInput struct {
+	ContactID int    `json:"contactId"` // The ID of the contact to update the value for. Required.
+	Value     string `json:"value"`     // Value of the custom field. Required.
+}
+
+// Validate checks the input for missing
+// fields, returning ValidationErrors.
+func (in *CustomFieldValueInput) Validate() error {
+	v := ValidationErrors{}
+
+	if in.ContactID == 0 {
+		v.Add("contactId", "contactId is required")
+	}
+
+	if in.Value == "" {
+		v.Add("value", "value is required")
+	}
+
+	if len(v) > 0 {
+		return v
+	}
+
+	return nil
+}
+
+// Params validates the input and encodes it into
+// the UpdateCustomFieldValue data payload.
+func (in *CustomFieldValueInput) Params() (Params, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
+	return Params{"contactId": strconv.Itoa(in.ContactID), "value": in.Value}, nil
 }
 
 // GetCustomField returns a single custom field by ID.
 func (c *Client) GetCustomField(id int) (*CustomField, error) {
+	return c.GetCustomFieldContext(context.Background(), id)
+}
+
+// GetCustomFieldContext is the context-aware form of GetCustomField.
+func (c *Client) GetCustomFieldContext(ctx context.Context, id int) (*CustomField, error) {
 	var f *CustomField
 
-	return f, c.get(customFieldURI+"/"+strconv.Itoa(id), nil, nil, &f)
+	return f, c.get(ctx, customFieldURI+"/"+strconv.Itoa(id), nil, nil, &f)
 }
 
 // CreateCustomField creates a new custom field
 // with the given name.
 func (c *Client) CreateCustomField(name string) (*NewCustomField, error) {
+	return c.CreateCustomFieldContext(context.Background(), name)
+}
+
+// CreateCustomFieldContext is the context-aware form of CreateCustomField.
+func (c *Client) CreateCustomFieldContext(ctx context.Context, name string) (*NewCustomField, error) {
 	var f *NewCustomField
 
-	return f, c.post(customFieldURI, nil, NewParams("name", name), &f)
+	return f, c.post(ctx, customFieldURI, nil, NewParams("name", name), &f)
 }
 
 // GetCustomFieldList returns the custom field list.
@@ -54,23 +100,43 @@ func (c *Client) CreateCustomField(name string) (*NewCustomField, error) {
 // - page:	Fetch specified results page.
 // - limit:	How many results on page.
 func (c *Client) GetCustomFieldList(p Params) (*CustomFieldList, error) {
+	return c.GetCustomFieldListContext(context.Background(), p)
+}
+
+// GetCustomFieldListContext is the context-aware form of GetCustomFieldList.
+func (c *Client) GetCustomFieldListContext(ctx context.Context, p Params) (*CustomFieldList, error) {
 	var l *CustomFieldList
 
-	return l, c.get(customFieldURI, p, nil, &l)
+	return l, c.get(ctx, customFieldURI, p, nil, &l)
+}
+
+// AllCustomFields returns an iterator over all custom fields.
+func (c *Client) AllCustomFields(ctx context.Context, p Params) iter.Seq2[*CustomField, error] {
+	return Paginate(ctx, p, c.GetCustomFieldListContext)
 }
 
 // UpdateCustomField updates the given custom field
 // to the provided name value.
 func (c *Client) UpdateCustomField(id int, name string) (*NewCustomField, error) {
+	return c.UpdateCustomFieldContext(context.Background(), id, name)
+}
+
+// UpdateCustomFieldContext is the context-aware form of UpdateCustomField.
+func (c *Client) UpdateCustomFieldContext(ctx context.Context, id int, name string) (*NewCustomField, error) {
 	var f *NewCustomField
 
-	return f, c.put(customFieldURI+"/"+strconv.Itoa(id), nil, NewParams("name", name), &f)
+	return f, c.put(ctx, customFieldURI+"/"+strconv.Itoa(id), nil, NewParams("name", name), &f)
 }
 
 // DeleteCustomField deletes the custom field
 // with the given ID.
 func (c *Client) DeleteCustomField(id int) error {
-	return c.delete(customFieldURI+"/"+strconv.Itoa(id), nil, nil, nil)
+	return c.DeleteCustomFieldContext(context.Background(), id)
+}
+
+// DeleteCustomFieldContext is the context-aware form of DeleteCustomField.
+func (c *Client) DeleteCustomFieldContext(ctx context.Context, id int) error {
+	return c.delete(ctx, customFieldURI+"/"+strconv.Itoa(id), nil, nil, nil)
 }
 
 // UpdateCustomFieldValue updates the contact's
@@ -80,7 +146,31 @@ func (c *Client) DeleteCustomField(id int) error {
 // - contactId:	The unique id of the Contact to update value. Required.
 // - value:     Value of CustomField. Required.
 func (c *Client) UpdateCustomFieldValue(id int, d Params) (*NewContact, error) {
+	return c.UpdateCustomFieldValueContext(context.Background(), id, d)
+}
+
+// UpdateCustomFieldValueContext is the context-aware form of UpdateCustomFieldValue.
+func (c *Client) UpdateCustomFieldValueContext(ctx context.Context, id int, d Params) (*NewContact, error) {
+	var contact *NewContact
+
+	return contact, c.put(ctx, customFieldURI+"/"+strconv.Itoa(id)+"/update", nil, d, &contact)
+}
+
+// UpdateCustomFieldValueFrom is like UpdateCustomFieldValue, but takes a typed
+// input which is validated before it is sent.
+func (c *Client) UpdateCustomFieldValueFrom(id int, in *CustomFieldValueInput) (*NewContact, error) {
+	return c.UpdateCustomFieldValueFromContext(context.Background(), id, in)
+}
+
+// UpdateCustomFieldValueFromContext is the context-aware form of UpdateCustomFieldValueFrom.
+func (c *Client) UpdateCustomFieldValueFromContext(ctx context.Context, id int, in *CustomFieldValueInput) (*NewContact, error) {
+	d, err := in.Params()
+
+	if err != nil {
+		return nil, err
+	}
+
 	var contact *NewContact
 
-	return contact, c.put(customFieldURI+"/"+strconv.Itoa(id)+"/update", nil, d, &contact)
+	return contact, c.request(ctx, "PUT", customFieldURI+"/"+strconv.Itoa(id)+"/update", nil, d, in, &contact)
 }
@@ -1,18 +1,60 @@
 package textmagic
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"sort"
+	"strings"
 )
 
-// ErrPing represents a ping error.
-var ErrPing = errors.New("unable to ping API")
+var (
+	// ErrPing represents a ping error.
+	ErrPing = errors.New("unable to ping API")
+
+	// ErrRateLimitExceeded is returned by clients configured with
+	// WithRateLimitFailFast when the client rate limit is exceeded.
+	// It also matches ErrRateLimited.
+	ErrRateLimitExceeded error = rateLimitError("client rate limit exceeded")
+
+	// ErrBulkFailed is returned by WaitForBulk when
+	// the bulk session fails to process.
+	ErrBulkFailed = errors.New("bulk session failed")
+)
+
+// Error classes, for use with errors.Is:
+//
+//	if errors.Is(err, textmagic.ErrNotFound) {
+//		// ...
+//	}
+var (
+	ErrNotFound          = errors.New("resource not found")
+	ErrUnauthorized      = errors.New("unauthorized")
+	ErrRateLimited       = errors.New("rate limited")
+	ErrValidation        = errors.New("validation failed")
+	ErrInsufficientFunds = errors.New("insufficient funds")
+)
 
 // Error represents a TextMagic API error.
 type Error struct {
 	Code    int                    `json:"code"`
 	Message string                 `json:"message"`
 	Errors  map[string]interface{} `json:"errors"`
+
+	// Common and Fields hold the general and per field error
+	// messages decoded from Errors.
+	Common []string         `json:"-"`
+	Fields ValidationErrors `json:"-"`
+
+	// Status, Header, Method and URI describe the HTTP exchange
+	// the error was returned for. Body holds the start of the
+	// response body when it could not be decoded as JSON.
+	Status int         `json:"-"`
+	Header http.Header `json:"-"`
+	Method string      `json:"-"`
+	URI    string      `json:"-"`
+	Body   string      `json:"-"`
 }
 
 // NewError returns a new Error with the
@@ -21,18 +63,151 @@ func NewError(c int, m string) *Error {
 	return &Error{Code: c, Message: m}
 }
 
+// UnmarshalJSON implements the json.Unmarshaler interface,
+// decoding the common and field errors of the payload.
+func (e *Error) UnmarshalJSON(b []byte) error {
+	type plain Error
+
+	var v struct {
+		*plain
+		Errors json.RawMessage `json:"errors"`
+	}
+
+	v.plain = (*plain)(e)
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	if len(v.Errors) == 0 {
+		return nil
+	}
+
+	var details struct {
+		Common []string         `json:"common"`
+		Fields ValidationErrors `json:"fields"`
+	}
+
+	// The errors payload has no fixed shape, so
+	// anything unexpected is left undecoded.
+	json.Unmarshal(v.Errors, &e.Errors)
+
+	if json.Unmarshal(v.Errors, &details) == nil {
+		e.Common = details.Common
+		e.Fields = details.Fields
+	}
+
+	return nil
+}
+
 // Error implements the error interface for
 // the Error struct.
 func (e *Error) Error() string {
-	var message = "TextMagic Rest API Error:"
+	var parts []string
 
 	if e.Code != 0 {
-		message += fmt.Sprintf(" Code: %d", e.Code)
-	} else if e.Message != "" {
-		message += fmt.Sprintf(", Message: %s", e.Message)
-	} else if len(e.Errors) > 0 {
-		message += fmt.Sprintf(", Errors: %s", e.Errors)
+		parts = append(parts, fmt.Sprintf("Code: %d", e.Code))
+	}
+
+	if e.Message != "" {
+		parts = append(parts, "Message: "+e.Message)
+	}
+
+	if len(e.Common) > 0 {
+		parts = append(parts, "Errors: "+strings.Join(e.Common, "; "))
+	}
+
+	if len(e.Fields) > 0 {
+		parts = append(parts, "Fields: "+e.Fields.Error())
+	} else if len(e.Common) == 0 && len(e.Errors) > 0 {
+		parts = append(parts, fmt.Sprintf("Errors: %v", e.Errors))
+	}
+
+	if e.Status != 0 && e.Status != e.Code {
+		parts = append(parts, fmt.Sprintf("Status: %d", e.Status))
+	}
+
+	if e.Method != "" {
+		parts = append(parts, "Request: "+e.Method+" "+e.URI)
 	}
 
-	return message
+	if e.Body != "" {
+		parts = append(parts, fmt.Sprintf("Body: %q", e.Body))
+	}
+
+	return "TextMagic Rest API Error: " + strings.Join(parts, ", ")
+}
+
+// Is reports whether the error belongs to the given
+// error class, such as ErrNotFound.
+func (e *Error) Is(target error) bool {
+	code := e.Status
+
+	if code == 0 {
+		code = e.Code
+	}
+
+	switch target {
+	case ErrNotFound:
+		return code == http.StatusNotFound
+
+	case ErrUnauthorized:
+		return code == http.StatusUnauthorized
+
+	case ErrRateLimited:
+		return code == http.StatusTooManyRequests
+
+	case ErrValidation:
+		return len(e.Fields) > 0 || code == http.StatusBadRequest || code == http.StatusUnprocessableEntity
+
+	case ErrInsufficientFunds:
+		return code == http.StatusPaymentRequired || strings.Contains(strings.ToLower(e.Message), "insufficient")
+	}
+
+	return false
+}
+
+// rateLimitError is a client-side rate limit
+// error, classed as ErrRateLimited.
+type rateLimitError string
+
+func (e rateLimitError) Error() string {
+	return string(e)
+}
+
+// Is reports whether target is ErrRateLimited.
+func (e rateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// ValidationErrors maps request fields to their error messages.
+// It is used for both API and client-side validation errors.
+type ValidationErrors map[string][]string
+
+// Add appends an error message for the given field.
+func (v ValidationErrors) Add(field, message string) {
+	v[field] = append(v[field], message)
+}
+
+// Error implements the error interface, listing
+// the field errors in field order.
+func (v ValidationErrors) Error() string {
+	fields := make([]string, 0, len(v))
+
+	for f := range v {
+		fields = append(fields, f)
+	}
+
+	sort.Strings(fields)
+
+	for i, f := range fields {
+		fields[i] = f + ": " + strings.Join(v[f], ", ")
+	}
+
+	return strings.Join(fields, "; ")
+}
+
+// Is reports whether target is ErrValidation.
+func (v ValidationErrors) Is(target error) bool {
+	return target == ErrValidation
 }
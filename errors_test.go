@@ -0,0 +1,112 @@
+package textmagic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrors(t *testing.T) {
+	var e *Error
+
+	payload := `{
+		"code": 400,
+		"message": "Validation Failed",
+		"errors": {
+			"common": ["Check the data"],
+			"fields": {"phone": ["This value is not valid"], "text": ["Required"]}
+		}
+	}`
+
+	err := json.Unmarshal([]byte(payload), &e)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 400, e.Code)
+	assert.Equal(t, []string{"Check the data"}, e.Common)
+	assert.Equal(t, ValidationErrors{
+		"phone": {"This value is not valid"},
+		"text":  {"Required"},
+	}, e.Fields)
+	assert.Equal(t, "TextMagic Rest API Error: Code: 400, Message: Validation Failed, "+
+		"Errors: Check the data, Fields: phone: This value is not valid; text: Required", e.Error())
+
+	// Classification
+
+	wrapped := fmt.Errorf("create contact: %w", e)
+
+	assert.True(t, errors.Is(wrapped, ErrValidation))
+	assert.False(t, errors.Is(wrapped, ErrNotFound))
+	assert.True(t, errors.Is(NewError(404, "Not Found"), ErrNotFound))
+	assert.True(t, errors.Is(NewError(401, "Unauthorized"), ErrUnauthorized))
+	assert.True(t, errors.Is(NewError(429, "Too Many Requests"), ErrRateLimited))
+	assert.True(t, errors.Is(NewError(402, "Payment Required"), ErrInsufficientFunds))
+
+	var target *Error
+
+	assert.True(t, errors.As(wrapped, &target))
+	assert.Equal(t, "Validation Failed", target.Message)
+
+	// Unexpected errors shape
+
+	e = nil
+	err = json.Unmarshal([]byte(`{"code":500,"message":"Oops","errors":["a"]}`), &e)
+
+	assert.Nil(t, err)
+	assert.Nil(t, e.Fields)
+	assert.Equal(t, "TextMagic Rest API Error: Code: 500, Message: Oops", e.Error())
+}
+
+func TestErrorResponses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/proxy":
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte("<html>Bad Gateway</html>"))
+
+		case "/empty":
+			w.WriteHeader(http.StatusUnauthorized)
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":404,"message":"Resource not found"}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient("", "", WithBaseURL(srv.URL), WithRetryPolicy(RetryPolicy{}))
+
+	// Non-JSON body
+
+	var e *Error
+
+	err := c.Request("GET", "proxy", nil, nil, nil)
+
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, http.StatusBadGateway, e.Status)
+	assert.Equal(t, "Bad Gateway", e.Message)
+	assert.Equal(t, "<html>Bad Gateway</html>", e.Body)
+	assert.Equal(t, "GET", e.Method)
+	assert.Equal(t, srv.URL+"/proxy", e.URI)
+	assert.Equal(t, "text/html", e.Header.Get("Content-Type"))
+
+	// Empty body
+
+	err = c.Request("GET", "empty", nil, nil, nil)
+
+	assert.True(t, errors.Is(err, ErrUnauthorized))
+
+	// JSON body
+
+	err = c.Request("GET", "contacts/1", nil, nil, nil)
+
+	assert.True(t, errors.As(err, &e))
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.Equal(t, "Resource not found", e.Message)
+	assert.Empty(t, e.Body)
+}
@@ -1,5 +1,10 @@
 package textmagic
 
+import (
+	"context"
+	"iter"
+)
+
 const invoiceURI = "invoices"
 
 // Invoice represents an invoice.
@@ -13,12 +18,7 @@ type Invoice struct {
 
 // InvoiceList represents a list of invoices
 // and pagination information.
-type InvoiceList struct {
-	Page      int        `json:"page"`
-	Limit     int        `json:"limit"`
-	PageCount int        `json:"pageCount"`
-	Resources []*Invoice `json:"resources"`
-}
+type InvoiceList = Page[*Invoice]
 
 // GetInvoiceList returns all user invoices.
 //
@@ -26,7 +26,17 @@ type InvoiceList struct {
 // - page:	Fetch specified results page.
 // - limit:	How many results on page.
 func (c *Client) GetInvoiceList(p Params) (*InvoiceList, error) {
+	return c.GetInvoiceListContext(context.Background(), p)
+}
+
+// GetInvoiceListContext is the context-aware form of GetInvoiceList.
+func (c *Client) GetInvoiceListContext(ctx context.Context, p Params) (*InvoiceList, error) {
 	var l *InvoiceList
 
-	return l, c.get(invoiceURI, p, nil, &l)
+	return l, c.get(ctx, invoiceURI, p, nil, &l)
+}
+
+// AllInvoices returns an iterator over all user invoices.
+func (c *Client) AllInvoices(ctx context.Context, p Params) iter.Seq2[*Invoice, error] {
+	return Paginate(ctx, p, c.GetInvoiceListContext)
 }
@@ -1,6 +1,10 @@
 package textmagic
 
-import "strconv"
+import (
+	"context"
+	"iter"
+	"strconv"
+)
 
 const listURI = "lists"
 
@@ -20,30 +24,96 @@ type List struct {
 }
 
 // Lists represents lists and pagination information.
-type Lists struct {
-	Page      int     `json:"page"`
-	Limit     int     `json:"limit"`
-	PageCount int     `json:"pageCount"`
-	Resources []*List `json:"resources"`
+type Lists = Page[*List]
+
+// ListInput represents the data of a list, as
+// used by CreateListFrom and UpdateListFrom.
+type ListInput struct {
+	Name        string `json:"name"`                  // List name. Required.
+	Description string `json:"description,omitempty"` // List description.
+	Shared      bool   `json:"shared"`                // Should this list be shared with sub-accounts.
+}
+
+// Validate checks the input for missing
+// fields, returning ValidationErrors.
+func (in *ListInput) Validate() error {
+	if in.Name == "" {
+		return ValidationErrors{"name": {"name is required"}}
+	}
+
+	return nil
+}
+
+// Params validates the input and encodes it
+// into the CreateList data payload.
+func (in *ListInput) Params() (Params, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
+	p := Params{
+		"name":   in.Name,
+		"shared": "0",
+	}
+
+	if in.Description != "" {
+		p["description"] = in.Description
+	}
+
+	if in.Shared {
+		p["shared"] = "1"
+	}
+
+	return p, nil
 }
 
 // GetList returns the list with the given ID.
 func (c *Client) GetList(id int) (*List, error) {
+	return c.GetListContext(context.Background(), id)
+}
+
+// GetListContext is the context-aware form of GetList.
+func (c *Client) GetListContext(ctx context.Context, id int) (*List, error) {
 	var l *List
 
-	return l, c.get(listURI+"/"+strconv.Itoa(id), nil, nil, &l)
+	return l, c.get(ctx, listURI+"/"+strconv.Itoa(id), nil, nil, &l)
 }
 
 // CreateList creates a new list with
 // the corresponding POST DATA.
 //
 // The data payload includes:
+// - name:        List name. Required.
 // - description: List description.
 // - shared:      Should this list be shared with sub-accounts. Can be 1 or 0.
 func (c *Client) CreateList(d Params) (*NewList, error) {
+	return c.CreateListContext(context.Background(), d)
+}
+
+// CreateListContext is the context-aware form of CreateList.
+func (c *Client) CreateListContext(ctx context.Context, d Params) (*NewList, error) {
 	var l *NewList
 
-	return l, c.post(listURI, nil, d, &l)
+	return l, c.post(ctx, listURI, nil, d, &l)
+}
+
+// CreateListFrom is like CreateList, but takes a typed
+// input which is validated before it is sent.
+func (c *Client) CreateListFrom(in *ListInput) (*NewList, error) {
+	return c.CreateListFromContext(context.Background(), in)
+}
+
+// CreateListFromContext is the context-aware form of CreateListFrom.
+func (c *Client) CreateListFromContext(ctx context.Context, in *ListInput) (*NewList, error) {
+	d, err := in.Params()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var l *NewList
+
+	return l, c.request(ctx, "POST", listURI, nil, d, in, &l)
 }
 
 // GetLists returns all user lists.
@@ -52,9 +122,19 @@ func (c *Client) CreateList(d Params) (*NewList, error) {
 // - page:	Fetch specified results page.
 // - limit:	How many results on page.
 func (c *Client) GetLists(p Params) (*Lists, error) {
+	return c.GetListsContext(context.Background(), p)
+}
+
+// GetListsContext is the context-aware form of GetLists.
+func (c *Client) GetListsContext(ctx context.Context, p Params) (*Lists, error) {
 	var l *Lists
 
-	return l, c.get(listURI, p, nil, &l)
+	return l, c.get(ctx, listURI, p, nil, &l)
+}
+
+// AllLists returns an iterator over all user lists.
+func (c *Client) AllLists(ctx context.Context, p Params) iter.Seq2[*List, error] {
+	return Paginate(ctx, p, c.GetListsContext)
 }
 
 // SearchLists returns all user lists for the given search.
@@ -65,9 +145,20 @@ func (c *Client) GetLists(p Params) (*Lists, error) {
 // - ids:	Find lists by ID(s).
 // - query:	Find lists by specified search query.
 func (c *Client) SearchLists(p Params) (*Lists, error) {
+	return c.SearchListsContext(context.Background(), p)
+}
+
+// SearchListsContext is the context-aware form of SearchLists.
+func (c *Client) SearchListsContext(ctx context.Context, p Params) (*Lists, error) {
 	var l *Lists
 
-	return l, c.get(listURI+"/search", p, nil, &l)
+	return l, c.get(ctx, listURI+"/search", p, nil, &l)
+}
+
+// SearchAllLists returns an iterator over all user lists
+// for the given search.
+func (c *Client) SearchAllLists(ctx context.Context, p Params) iter.Seq2[*List, error] {
+	return Paginate(ctx, p, c.SearchListsContext)
 }
 
 // UpdateList updates the list for the given ID.
@@ -77,14 +168,43 @@ func (c *Client) SearchLists(p Params) (*Lists, error) {
 // - description: List description.
 // - shared:      Should this list be shared with sub-accounts. Can be 1 or 0. Default = 0.
 func (c *Client) UpdateList(id int, d Params) (*NewList, error) {
+	return c.UpdateListContext(context.Background(), id, d)
+}
+
+// UpdateListContext is the context-aware form of UpdateList.
+func (c *Client) UpdateListContext(ctx context.Context, id int, d Params) (*NewList, error) {
 	var l *NewList
 
-	return l, c.put(listURI+"/"+strconv.Itoa(id), nil, d, &l)
+	return l, c.put(ctx, listURI+"/"+strconv.Itoa(id), nil, d, &l)
+}
+
+// UpdateListFrom is like UpdateList, but takes a typed
+// input which is validated before it is sent.
+func (c *Client) UpdateListFrom(id int, in *ListInput) (*NewList, error) {
+	return c.UpdateListFromContext(context.Background(), id, in)
+}
+
+// UpdateListFromContext is the context-aware form of UpdateListFrom.
+func (c *Client) UpdateListFromContext(ctx context.Context, id int, in *ListInput) (*NewList, error) {
+	d, err := in.Params()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var l *NewList
+
+	return l, c.request(ctx, "PUT", listURI+"/"+strconv.Itoa(id), nil, d, in, &l)
 }
 
 // DeleteList deletes the list with the given ID.
 func (c *Client) DeleteList(id int) error {
-	return c.delete(listURI+"/"+strconv.Itoa(id), nil, nil, nil)
+	return c.DeleteListContext(context.Background(), id)
+}
+
+// DeleteListContext is the context-aware form of DeleteList.
+func (c *Client) DeleteListContext(ctx context.Context, id int) error {
+	return c.delete(ctx, listURI+"/"+strconv.Itoa(id), nil, nil, nil)
 }
 
 // GetContactsInList fetches the contacts for
@@ -94,20 +214,43 @@ func (c *Client) DeleteList(id int) error {
 // - page:	Fetch specified results page.
 // - limit:	How many results on page.
 func (c *Client) GetContactsInList(id int, p Params) (*ContactList, error) {
+	return c.GetContactsInListContext(context.Background(), id, p)
+}
+
+// GetContactsInListContext is the context-aware form of GetContactsInList.
+func (c *Client) GetContactsInListContext(ctx context.Context, id int, p Params) (*ContactList, error) {
 	var l *ContactList
 
-	return l, c.get(listURI+"/"+strconv.Itoa(id)+"/contacts", p, nil, &l)
+	return l, c.get(ctx, listURI+"/"+strconv.Itoa(id)+"/contacts", p, nil, &l)
+}
+
+// AllContactsInList returns an iterator over all contacts
+// for the given list ID.
+func (c *Client) AllContactsInList(ctx context.Context, id int, p Params) iter.Seq2[*Contact, error] {
+	return Paginate(ctx, p, func(ctx context.Context, p Params) (*Page[*Contact], error) {
+		return c.GetContactsInListContext(ctx, id, p)
+	})
 }
 
 // PutContactsIntoList assigns comma separated contacts
 // string to the list with the given ID.
 func (c *Client) PutContactsIntoList(id int, contacts ...int) (*NewList, error) {
+	return c.PutContactsIntoListContext(context.Background(), id, contacts...)
+}
+
+// PutContactsIntoListContext is the context-aware form of PutContactsIntoList.
+func (c *Client) PutContactsIntoListContext(ctx context.Context, id int, contacts ...int) (*NewList, error) {
 	var l *NewList
 
-	return l, c.put(listURI+"/"+strconv.Itoa(id)+"/contacts", nil, NewParams("contacts", contacts), &l)
+	return l, c.put(ctx, listURI+"/"+strconv.Itoa(id)+"/contacts", nil, NewParams("contacts", contacts), &l)
 }
 
 // DeleteContactsFromList deletes contacts from the given list.
 func (c *Client) DeleteContactsFromList(id int, contacts ...int) error {
-	return c.delete(listURI+"/"+strconv.Itoa(id)+"/contacts", nil, NewParams("contacts", contacts), nil)
+	return c.DeleteContactsFromListContext(context.Background(), id, contacts...)
+}
+
+// DeleteContactsFromListContext is the context-aware form of DeleteContactsFromList.
+func (c *Client) DeleteContactsFromListContext(ctx context.Context, id int, contacts ...int) error {
+	return c.delete(ctx, listURI+"/"+strconv.Itoa(id)+"/contacts", nil, NewParams("contacts", contacts), nil)
 }
@@ -1,6 +1,13 @@
 package textmagic
 
-import "strconv"
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+)
 
 const (
 	messageURI   = "messages"
@@ -24,65 +31,50 @@ type NewMessage struct {
 
 // Message represents a message.
 type Message struct {
-	ID          int     `json:"id"`
-	Receiver    string  `json:"receiver"`
-	MessageTime string  `json:"messageTime"`
-	Status      string  `json:"status"`
-	Text        string  `json:"text"`
-	Charset     string  `json:"charset"`
-	FirstName   string  `json:"firstName"`
-	LastName    string  `json:"lastName"`
-	Country     string  `json:"country"`
-	Sender      string  `json:"sender"`
-	Price       float64 `json:"price"`
-	PartsCount  int     `json:"partsCount"`
+	ID          int           `json:"id"`
+	Receiver    string        `json:"receiver"`
+	MessageTime Time          `json:"messageTime"`
+	Status      MessageStatus `json:"status"`
+	Text        string        `json:"text"`
+	Charset     string        `json:"charset"`
+	FirstName   string        `json:"firstName"`
+	LastName    string        `json:"lastName"`
+	Country     string        `json:"country"`
+	Sender      string        `json:"sender"`
+	Price       Money         `json:"price"`
+	PartsCount  int           `json:"partsCount"`
 }
 
 // MessageList represents a message list.
-type MessageList struct {
-	Page      int        `json:"page"`
-	Limit     int        `json:"limit"`
-	PageCount int        `json:"pageCount"`
-	Resources []*Message `json:"resources"`
-}
+type MessageList = Page[*Message]
 
 // Session represents a session.
 type Session struct {
-	ID           int     `json:"id"`
-	StartTime    string  `json:"startTime"`
-	Text         string  `json:"text"`
-	Source       string  `json:"source"`
-	ReferenceID  string  `json:"referenceId"`
-	Price        float64 `json:"price"`
-	NumbersCount int     `json:"numbersCount"`
+	ID           int    `json:"id"`
+	StartTime    Time   `json:"startTime"`
+	Text         string `json:"text"`
+	Source       string `json:"source"`
+	ReferenceID  string `json:"referenceId"`
+	Price        Money  `json:"price"`
+	NumbersCount int    `json:"numbersCount"`
 }
 
 // SessionList represents a session list.
-type SessionList struct {
-	Page      int        `json:"page"`
-	Limit     int        `json:"limit"`
-	PageCount int        `json:"pageCount"`
-	Resources []*Session `json:"resources"`
-}
+type SessionList = Page[*Session]
 
 // BulkSession represents a bulk session.
 type BulkSession struct {
-	ID             int      `json:"id"`
-	Status         string   `json:"status"`
-	ItemsProcessed int      `json:"itemsProcessed"`
-	ItemsTotal     int      `json:"itemsTotal"`
-	CreatedAt      string   `json:"createdAt"`
-	Session        *Session `json:"session"`
-	Text           string   `json:"text"`
+	ID             int        `json:"id"`
+	Status         BulkStatus `json:"status"`
+	ItemsProcessed int        `json:"itemsProcessed"`
+	ItemsTotal     int        `json:"itemsTotal"`
+	CreatedAt      Time       `json:"createdAt"`
+	Session        *Session   `json:"session"`
+	Text           string     `json:"text"`
 }
 
 // BulkSessionList represents a bulk session list.
-type BulkSessionList struct {
-	Page      int            `json:"page"`
-	Limit     int            `json:"limit"`
-	PageCount int            `json:"pageCount"`
-	Resources []*BulkSession `json:"resources"`
-}
+type BulkSessionList = Page[*BulkSession]
 
 // Chat represents a chat item.
 type Chat struct {
@@ -90,47 +82,37 @@ type Chat struct {
 	Phone     string   `json:"phone"`
 	Contact   *Contact `json:"contact"`
 	Unread    int      `json:"unread"`
-	UpdatedAt string   `json:"updatedAt"`
+	UpdatedAt Time     `json:"updatedAt"`
 }
 
 // ChatList represents a chat item list.
-type ChatList struct {
-	Page      int     `json:"page"`
-	Limit     int     `json:"limit"`
-	PageCount int     `json:"pageCount"`
-	Resources []*Chat `json:"resources"`
-}
+type ChatList = Page[*Chat]
 
 // ChatMessage represents a chat message.
 type ChatMessage struct {
-	ID          int    `json:"id"`
-	Sender      string `json:"sender"`
-	MessageTime string `json:"messageTime"`
-	Text        string `json:"text"`
-	Receiver    string `json:"receiver"`
-	Status      string `json:"status"`
-	FirstName   string `json:"firstName"`
-	LastName    string `json:"lastName"`
+	ID          int           `json:"id"`
+	Sender      string        `json:"sender"`
+	MessageTime Time          `json:"messageTime"`
+	Text        string        `json:"text"`
+	Receiver    string        `json:"receiver"`
+	Status      MessageStatus `json:"status"`
+	FirstName   string        `json:"firstName"`
+	LastName    string        `json:"lastName"`
 }
 
 // ChatMessageList represents a chat message list.
-type ChatMessageList struct {
-	Page      int            `json:"page"`
-	Limit     int            `json:"limit"`
-	PageCount int            `json:"pageCount"`
-	Resources []*ChatMessage `json:"resources"`
-}
+type ChatMessageList = Page[*ChatMessage]
 
 // CountryPrice represents a country price.
 type CountryPrice struct {
-	Country string  `json:"country"`
-	Count   int     `json:"count"`
-	Max     float64 `json:"max"`
+	Country string `json:"country"`
+	Count   int    `json:"count"`
+	Max     Money  `json:"max"`
 }
 
 // MessagePrice represents a message price.
 type MessagePrice struct {
-	Total     float64                  `json:"total"`
+	Total     Money                    `json:"total"`
 	Parts     int                      `json:"parts"`
 	Countries map[string]*CountryPrice `json:"countries"`
 }
@@ -139,33 +121,194 @@ type MessagePrice struct {
 type Reply struct {
 	ID          int    `json:"id"`
 	Sender      string `json:"sender"`
-	MessageTime string `json:"messageTime"`
+	MessageTime Time   `json:"messageTime"`
 	Text        string `json:"text"`
 	Receiver    string `json:"receiver"`
 }
 
 // ReplyList represents a reply list.
-type ReplyList struct {
-	Page      int      `json:"page"`
-	Limit     int      `json:"limit"`
-	PageCount int      `json:"pageCount"`
-	Resources []*Reply `json:"resources"`
-}
+type ReplyList = Page[*Reply]
 
 // Scheduled represents a scheduled item.
 type Scheduled struct {
-	ID       int      `json:"id"`
-	NextSend string   `json:"nextSend"`
-	Rrule    string   `json:"rrule"`
-	Session  *Session `json:"session"`
+	ID         int         `json:"id"`
+	NextSend   Time        `json:"nextSend"`
+	Rrule      string      `json:"rrule"`
+	Paused     bool        `json:"paused"`
+	Recipients *Recipients `json:"recipients"`
+	Session    *Session    `json:"session"`
 }
 
 // ScheduledList represents a scheduled item list.
-type ScheduledList struct {
-	Page      int          `json:"page"`
-	Limit     int          `json:"limit"`
-	PageCount int          `json:"pageCount"`
-	Resources []*Scheduled `json:"resources"`
+type ScheduledList = Page[*Scheduled]
+
+// MessageRequest represents the data of a new outbound message,
+// as used by CreateMessageFrom and GetMessagePriceFrom.
+type MessageRequest struct {
+	Text        string    // Message text. Required if TemplateID is not set.
+	TemplateID  int       // Template used instead of message text. Required if Text is not set.
+	SendingTime time.Time // Message sending time. Default is now. Required with Rrule set.
+	Contacts    []int     // Contact IDs the message will be sent to.
+	Lists       []int     // List IDs the message will be sent to.
+	Phones      []string  // Phone numbers the message will be sent to.
+	CutExtra    bool      // Cut characters not fitting PartsCount instead of failing.
+	PartsCount  int       // Maximum message parts count, from 1 to 6.
+	ReferenceID string    // Custom message reference ID.
+	From        string    // One of the allowed sender IDs.
+	Rrule       string    // iCal RRULE to create recurrent scheduled messages, see RRule.
+
+	// TransliterateGSM replaces characters in Text which would force
+	// the message into UCS-2 with GSM-7 equivalents before sending.
+	// Call Replacements to see what is changed.
+	TransliterateGSM bool
+}
+
+// Validate checks the request for missing and
+// conflicting fields, returning ValidationErrors.
+func (r *MessageRequest) Validate() error {
+	v := ValidationErrors{}
+
+	if r.Text == "" && r.TemplateID == 0 {
+		v.Add("text", "text or templateId is required")
+	} else if r.Text != "" && r.TemplateID != 0 {
+		v.Add("templateId", "text and templateId are mutually exclusive")
+	}
+
+	if len(r.Contacts) == 0 && len(r.Lists) == 0 && len(r.Phones) == 0 {
+		v.Add("phones", "at least one of contacts, lists or phones is required")
+	}
+
+	if r.Rrule != "" && r.SendingTime.IsZero() {
+		v.Add("sendingTime", "sendingTime is required with rrule")
+	}
+
+	if _, err := ParseRRule(r.Rrule); r.Rrule != "" && err != nil {
+		v.Add("rrule", err.Error())
+	}
+
+	if r.PartsCount < 0 || r.PartsCount > 6 {
+		v.Add("partsCount", "partsCount must be between 1 and 6")
+	}
+
+	if len(v) > 0 {
+		return v
+	}
+
+	return nil
+}
+
+// Params validates the request and encodes it
+// into the CreateMessage data payload.
+func (r *MessageRequest) Params() (Params, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	p := Params{}
+
+	if r.Text != "" {
+		p["text"] = r.text()
+	}
+
+	if r.TemplateID != 0 {
+		p["templateId"] = strconv.Itoa(r.TemplateID)
+	}
+
+	if !r.SendingTime.IsZero() {
+		p["sendingTime"] = strconv.FormatInt(r.SendingTime.Unix(), 10)
+	}
+
+	if len(r.Contacts) > 0 {
+		p["contacts"] = joinIntSlice(r.Contacts)
+	}
+
+	if len(r.Lists) > 0 {
+		p["lists"] = joinIntSlice(r.Lists)
+	}
+
+	if len(r.Phones) > 0 {
+		p["phones"] = strings.Join(r.Phones, ",")
+	}
+
+	if r.CutExtra {
+		p["cutExtra"] = "1"
+	}
+
+	if r.PartsCount != 0 {
+		p["partsCount"] = strconv.Itoa(r.PartsCount)
+	}
+
+	if r.ReferenceID != "" {
+		p["referenceId"] = r.ReferenceID
+	}
+
+	if r.From != "" {
+		p["from"] = r.From
+	}
+
+	if r.Rrule != "" {
+		p["rrule"] = r.Rrule
+	}
+
+	return p, nil
+}
+
+// Replacements returns the changes TransliterateGSM makes
+// to Text when sent, or nil when it is not enabled.
+func (r *MessageRequest) Replacements() []Replacement {
+	if !r.TransliterateGSM {
+		return nil
+	}
+
+	_, rep := TransliterateGSM(r.Text)
+
+	return rep
+}
+
+// text returns the message text to send.
+func (r *MessageRequest) text() string {
+	if r.TransliterateGSM {
+		t, _ := TransliterateGSM(r.Text)
+
+		return t
+	}
+
+	return r.Text
+}
+
+// MarshalJSON implements the json.Marshaler interface,
+// encoding the request as a CreateMessage JSON body.
+func (r *MessageRequest) MarshalJSON() ([]byte, error) {
+	v := struct {
+		Text        string `json:"text,omitempty"`
+		TemplateID  int    `json:"templateId,omitempty"`
+		SendingTime int64  `json:"sendingTime,omitempty"`
+		Contacts    string `json:"contacts,omitempty"`
+		Lists       string `json:"lists,omitempty"`
+		Phones      string `json:"phones,omitempty"`
+		CutExtra    bool   `json:"cutExtra,omitempty"`
+		PartsCount  int    `json:"partsCount,omitempty"`
+		ReferenceID string `json:"referenceId,omitempty"`
+		From        string `json:"from,omitempty"`
+		Rrule       string `json:"rrule,omitempty"`
+	}{
+		Text:        r.text(),
+		TemplateID:  r.TemplateID,
+		Contacts:    joinIntSlice(r.Contacts),
+		Lists:       joinIntSlice(r.Lists),
+		Phones:      strings.Join(r.Phones, ","),
+		CutExtra:    r.CutExtra,
+		PartsCount:  r.PartsCount,
+		ReferenceID: r.ReferenceID,
+		From:        r.From,
+		Rrule:       r.Rrule,
+	}
+
+	if !r.SendingTime.IsZero() {
+		v.SendingTime = r.SendingTime.Unix()
+	}
+
+	return json.Marshal(v)
 }
 
 // CreateMessage creates and sends a new outbound
@@ -184,16 +327,45 @@ type ScheduledList struct {
 // - from:			One of allowed Sender ID (phone number or alphanumeric sender ID).
 // - rrule:			iCal RRULE parameter to create recurrent scheduled messages. When used, sending_time is mandatory as start point of sending.
 func (c *Client) CreateMessage(d Params) (*NewMessage, error) {
+	return c.CreateMessageContext(context.Background(), d)
+}
+
+// CreateMessageContext is the context-aware form of CreateMessage.
+func (c *Client) CreateMessageContext(ctx context.Context, d Params) (*NewMessage, error) {
 	var m *NewMessage
 
-	return m, c.post(messageURI, nil, d, &m)
+	return m, c.post(ctx, messageURI, nil, d, &m)
+}
+
+// CreateMessageFrom is like CreateMessage, but takes a typed
+// request which is validated before it is sent.
+func (c *Client) CreateMessageFrom(r *MessageRequest) (*NewMessage, error) {
+	return c.CreateMessageFromContext(context.Background(), r)
+}
+
+// CreateMessageFromContext is the context-aware form of CreateMessageFrom.
+func (c *Client) CreateMessageFromContext(ctx context.Context, r *MessageRequest) (*NewMessage, error) {
+	d, err := r.Params()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var m *NewMessage
+
+	return m, c.request(ctx, "POST", messageURI, nil, d, r, &m)
 }
 
 // GetMessage returns a single outgoing message by ID.
 func (c *Client) GetMessage(id int) (*Message, error) {
+	return c.GetMessageContext(context.Background(), id)
+}
+
+// GetMessageContext is the context-aware form of GetMessage.
+func (c *Client) GetMessageContext(ctx context.Context, id int) (*Message, error) {
 	var m *Message
 
-	return m, c.get(messageURI+"/"+strconv.Itoa(id), nil, nil, &m)
+	return m, c.get(ctx, messageURI+"/"+strconv.Itoa(id), nil, nil, &m)
 }
 
 // GetMessageList returns all user outbound messages.
@@ -202,9 +374,29 @@ func (c *Client) GetMessage(id int) (*Message, error) {
 // - page:	Fetch specified results page.
 // - limit:	How many results on page.
 func (c *Client) GetMessageList(p Params, search bool) (*MessageList, error) {
+	return c.GetMessageListContext(context.Background(), p, search)
+}
+
+// GetMessageListContext is the context-aware form of GetMessageList.
+func (c *Client) GetMessageListContext(ctx context.Context, p Params, search bool) (*MessageList, error) {
 	var l *MessageList
 
-	return l, c.get(messageURI, p, nil, &l)
+	return l, c.get(ctx, messageURI, p, nil, &l)
+}
+
+// AllMessages returns an iterator over all user outbound messages.
+func (c *Client) AllMessages(ctx context.Context, p Params) iter.Seq2[*Message, error] {
+	return Paginate(ctx, p, func(ctx context.Context, p Params) (*Page[*Message], error) {
+		return c.GetMessageListContext(ctx, p, false)
+	})
+}
+
+// WalkMessages is like AllMessages, but fetches up to
+// workers pages concurrently. See WalkPages.
+func (c *Client) WalkMessages(ctx context.Context, p Params, workers int) iter.Seq2[*Message, error] {
+	return WalkPages(ctx, p, workers, func(ctx context.Context, p Params) (*Page[*Message], error) {
+		return c.GetMessageListContext(ctx, p, false)
+	})
 }
 
 // SearchMessageList returns all user outbound messages
@@ -217,17 +409,33 @@ func (c *Client) GetMessageList(p Params, search bool) (*MessageList, error) {
 // - sessionId:	Find messages by session ID.
 // - query:		Find messages by specified search query.
 func (c *Client) SearchMessageList(p Params) (*MessageList, error) {
+	return c.SearchMessageListContext(context.Background(), p)
+}
+
+// SearchMessageListContext is the context-aware form of SearchMessageList.
+func (c *Client) SearchMessageListContext(ctx context.Context, p Params) (*MessageList, error) {
 	var l *MessageList
 
-	return l, c.get(messageURI+"/search", p, nil, &l)
+	return l, c.get(ctx, messageURI+"/search", p, nil, &l)
+}
+
+// SearchAllMessages returns an iterator over all user outbound
+// messages for the given search.
+func (c *Client) SearchAllMessages(ctx context.Context, p Params) iter.Seq2[*Message, error] {
+	return Paginate(ctx, p, c.SearchMessageListContext)
 }
 
 // GetBulkSession returns the bulk message
 // session by ID.
 func (c *Client) GetBulkSession(id int) (*BulkSession, error) {
+	return c.GetBulkSessionContext(context.Background(), id)
+}
+
+// GetBulkSessionContext is the context-aware form of GetBulkSession.
+func (c *Client) GetBulkSessionContext(ctx context.Context, id int) (*BulkSession, error) {
 	var b *BulkSession
 
-	return b, c.get(bulkURI, nil, nil, &b)
+	return b, c.get(ctx, bulkURI+"/"+strconv.Itoa(id), nil, nil, &b)
 }
 
 // GetBulkSessionList returns all bulk sending sessions.
@@ -236,9 +444,19 @@ func (c *Client) GetBulkSession(id int) (*BulkSession, error) {
 // - page:	Fetch specified results page.
 // - limit:	How many results on page.
 func (c *Client) GetBulkSessionList(p Params) (*BulkSessionList, error) {
+	return c.GetBulkSessionListContext(context.Background(), p)
+}
+
+// GetBulkSessionListContext is the context-aware form of GetBulkSessionList.
+func (c *Client) GetBulkSessionListContext(ctx context.Context, p Params) (*BulkSessionList, error) {
 	var l *BulkSessionList
 
-	return l, c.get(bulkURI, p, nil, &l)
+	return l, c.get(ctx, bulkURI, p, nil, &l)
+}
+
+// AllBulkSessions returns an iterator over all bulk sending sessions.
+func (c *Client) AllBulkSessions(ctx context.Context, p Params) iter.Seq2[*BulkSession, error] {
+	return Paginate(ctx, p, c.GetBulkSessionListContext)
 }
 
 // GetChatMessageList returns all messages from
@@ -248,9 +466,22 @@ func (c *Client) GetBulkSessionList(p Params) (*BulkSessionList, error) {
 // - page:	Fetch specified results page.
 // - limit:	How many results on page.
 func (c *Client) GetChatMessageList(phone string, p Params) (*ChatMessageList, error) {
+	return c.GetChatMessageListContext(context.Background(), phone, p)
+}
+
+// GetChatMessageListContext is the context-aware form of GetChatMessageList.
+func (c *Client) GetChatMessageListContext(ctx context.Context, phone string, p Params) (*ChatMessageList, error) {
 	var l *ChatMessageList
 
-	return l, c.get(chatURI, p, nil, &l)
+	return l, c.get(ctx, chatURI+"/"+phone, p, nil, &l)
+}
+
+// AllChatMessages returns an iterator over all messages from
+// chat with specified phone number.
+func (c *Client) AllChatMessages(ctx context.Context, phone string, p Params) iter.Seq2[*ChatMessage, error] {
+	return Paginate(ctx, p, func(ctx context.Context, p Params) (*Page[*ChatMessage], error) {
+		return c.GetChatMessageListContext(ctx, phone, p)
+	})
 }
 
 // GetChatList returns all user chats.
@@ -259,9 +490,19 @@ func (c *Client) GetChatMessageList(phone string, p Params) (*ChatMessageList, e
 // - page:	Fetch specified results page.
 // - limit:	How many results on page.
 func (c *Client) GetChatList(p Params) (*ChatList, error) {
+	return c.GetChatListContext(context.Background(), p)
+}
+
+// GetChatListContext is the context-aware form of GetChatList.
+func (c *Client) GetChatListContext(ctx context.Context, p Params) (*ChatList, error) {
 	var l *ChatList
 
-	return l, c.get(chatURI, p, nil, &l)
+	return l, c.get(ctx, chatURI, p, nil, &l)
+}
+
+// AllChats returns an iterator over all user chats.
+func (c *Client) AllChats(ctx context.Context, p Params) iter.Seq2[*Chat, error] {
+	return Paginate(ctx, p, c.GetChatListContext)
 }
 
 // GetMessagePrice checks pricing for a
@@ -280,21 +521,53 @@ func (c *Client) GetChatList(p Params) (*ChatList, error) {
 // - from:         	One of allowed Sender ID (phone number or alphanumeric sender ID).
 // - rrule:        	iCal RRULE parameter to create recurrent scheduled messages. When used, sending_time is mandatory as start point of sending.
 func (c *Client) GetMessagePrice(p Params) (*MessagePrice, error) {
+	return c.GetMessagePriceContext(context.Background(), p)
+}
+
+// GetMessagePriceContext is the context-aware form of GetMessagePrice.
+func (c *Client) GetMessagePriceContext(ctx context.Context, p Params) (*MessagePrice, error) {
 	var m *MessagePrice
 
-	return m, c.get(messageURI+"/price", p, nil, &m)
+	return m, c.get(ctx, messageURI+"/price", p, nil, &m)
+}
+
+// GetMessagePriceFrom is like GetMessagePrice, but takes a
+// typed request which is validated before it is sent.
+func (c *Client) GetMessagePriceFrom(r *MessageRequest) (*MessagePrice, error) {
+	return c.GetMessagePriceFromContext(context.Background(), r)
+}
+
+// GetMessagePriceFromContext is the context-aware form of GetMessagePriceFrom.
+func (c *Client) GetMessagePriceFromContext(ctx context.Context, r *MessageRequest) (*MessagePrice, error) {
+	p, err := r.Params()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetMessagePriceContext(ctx, p)
 }
 
 // DeleteMessage deletes the message with the given ID.
 func (c *Client) DeleteMessage(id int) error {
-	return c.delete(messageURI+"/"+strconv.Itoa(id), nil, nil, nil)
+	return c.DeleteMessageContext(context.Background(), id)
+}
+
+// DeleteMessageContext is the context-aware form of DeleteMessage.
+func (c *Client) DeleteMessageContext(ctx context.Context, id int) error {
+	return c.delete(ctx, messageURI+"/"+strconv.Itoa(id), nil, nil, nil)
 }
 
 // GetReply returns a single inbound message by ID.
 func (c *Client) GetReply(id int) (*Reply, error) {
+	return c.GetReplyContext(context.Background(), id)
+}
+
+// GetReplyContext is the context-aware form of GetReply.
+func (c *Client) GetReplyContext(ctx context.Context, id int) (*Reply, error) {
 	var r *Reply
 
-	return r, c.get(replyURI+"/"+strconv.Itoa(id), nil, nil, &r)
+	return r, c.get(ctx, replyURI+"/"+strconv.Itoa(id), nil, nil, &r)
 }
 
 // GetReplyList returns all user inbound messages.
@@ -303,9 +576,21 @@ func (c *Client) GetReply(id int) (*Reply, error) {
 // - page:	Fetch specified results page.
 // - limit:	How many results on page.
 func (c *Client) GetReplyList(p Params, search bool) (*ReplyList, error) {
+	return c.GetReplyListContext(context.Background(), p, search)
+}
+
+// GetReplyListContext is the context-aware form of GetReplyList.
+func (c *Client) GetReplyListContext(ctx context.Context, p Params, search bool) (*ReplyList, error) {
 	var l *ReplyList
 
-	return l, c.get(replyURI, p, nil, &l)
+	return l, c.get(ctx, replyURI, p, nil, &l)
+}
+
+// AllReplies returns an iterator over all user inbound messages.
+func (c *Client) AllReplies(ctx context.Context, p Params) iter.Seq2[*Reply, error] {
+	return Paginate(ctx, p, func(ctx context.Context, p Params) (*Page[*Reply], error) {
+		return c.GetReplyListContext(ctx, p, false)
+	})
 }
 
 // SearchReplyList returns all user chats.
@@ -316,22 +601,43 @@ func (c *Client) GetReplyList(p Params, search bool) (*ReplyList, error) {
 // - ids:		Find replies by ID(s).
 // - query:		Find replies by specified search query.
 func (c *Client) SearchReplyList(p Params) (*ReplyList, error) {
+	return c.SearchReplyListContext(context.Background(), p)
+}
+
+// SearchReplyListContext is the context-aware form of SearchReplyList.
+func (c *Client) SearchReplyListContext(ctx context.Context, p Params) (*ReplyList, error) {
 	var l *ReplyList
 
-	return l, c.get(replyURI+"/search", p, nil, &l)
+	return l, c.get(ctx, replyURI+"/search", p, nil, &l)
+}
+
+// SearchAllReplies returns an iterator over all user inbound
+// messages for the given search.
+func (c *Client) SearchAllReplies(ctx context.Context, p Params) iter.Seq2[*Reply, error] {
+	return Paginate(ctx, p, c.SearchReplyListContext)
 }
 
 // DeleteReply deletes the reply with the given ID.
 func (c *Client) DeleteReply(id int) error {
-	return c.delete(replyURI+"/"+strconv.Itoa(id), nil, nil, nil)
+	return c.DeleteReplyContext(context.Background(), id)
+}
+
+// DeleteReplyContext is the context-aware form of DeleteReply.
+func (c *Client) DeleteReplyContext(ctx context.Context, id int) error {
+	return c.delete(ctx, replyURI+"/"+strconv.Itoa(id), nil, nil, nil)
 }
 
 // GetScheduled returns the single scheduled item
 // with the given ID.
 func (c *Client) GetScheduled(id int) (*Scheduled, error) {
+	return c.GetScheduledContext(context.Background(), id)
+}
+
+// GetScheduledContext is the context-aware form of GetScheduled.
+func (c *Client) GetScheduledContext(ctx context.Context, id int) (*Scheduled, error) {
 	var s *Scheduled
 
-	return s, c.get(scheduledURI+"/"+strconv.Itoa(id), nil, nil, &s)
+	return s, c.get(ctx, scheduledURI+"/"+strconv.Itoa(id), nil, nil, &s)
 }
 
 // GetScheduledList returns all user scheduled messages.
@@ -340,23 +646,43 @@ func (c *Client) GetScheduled(id int) (*Scheduled, error) {
 // - page:	Fetch specified results page.
 // - limit:	How many results on page.
 func (c *Client) GetScheduledList(p Params) (*ScheduledList, error) {
+	return c.GetScheduledListContext(context.Background(), p)
+}
+
+// GetScheduledListContext is the context-aware form of GetScheduledList.
+func (c *Client) GetScheduledListContext(ctx context.Context, p Params) (*ScheduledList, error) {
 	var l *ScheduledList
 
-	return l, c.get(scheduledURI, p, nil, &l)
+	return l, c.get(ctx, scheduledURI, p, nil, &l)
+}
+
+// AllScheduled returns an iterator over all user scheduled messages.
+func (c *Client) AllScheduled(ctx context.Context, p Params) iter.Seq2[*Scheduled, error] {
+	return Paginate(ctx, p, c.GetScheduledListContext)
 }
 
 // DeleteScheduled deletes the scheduled message
 // with the given ID.
 func (c *Client) DeleteScheduled(id int) error {
-	return c.delete(scheduledURI+"/"+strconv.Itoa(id), nil, nil, nil)
+	return c.DeleteScheduledContext(context.Background(), id)
+}
+
+// DeleteScheduledContext is the context-aware form of DeleteScheduled.
+func (c *Client) DeleteScheduledContext(ctx context.Context, id int) error {
+	return c.delete(ctx, scheduledURI+"/"+strconv.Itoa(id), nil, nil, nil)
 }
 
 // GetSession returns the single session
 // with the given ID.
 func (c *Client) GetSession(id int) (*Session, error) {
+	return c.GetSessionContext(context.Background(), id)
+}
+
+// GetSessionContext is the context-aware form of GetSession.
+func (c *Client) GetSessionContext(ctx context.Context, id int) (*Session, error) {
 	var s *Session
 
-	return s, c.get(sessionURI+"/"+strconv.Itoa(id), nil, nil, &s)
+	return s, c.get(ctx, sessionURI+"/"+strconv.Itoa(id), nil, nil, &s)
 }
 
 // GetSessionList returns all user message sessions.
@@ -365,15 +691,30 @@ func (c *Client) GetSession(id int) (*Session, error) {
 // - page:	Fetch specified results page.
 // - limit:	How many results on page.
 func (c *Client) GetSessionList(p Params) (*SessionList, error) {
+	return c.GetSessionListContext(context.Background(), p)
+}
+
+// GetSessionListContext is the context-aware form of GetSessionList.
+func (c *Client) GetSessionListContext(ctx context.Context, p Params) (*SessionList, error) {
 	var l *SessionList
 
-	return l, c.get(sessionURI, p, nil, &l)
+	return l, c.get(ctx, sessionURI, p, nil, &l)
+}
+
+// AllSessions returns an iterator over all user message sessions.
+func (c *Client) AllSessions(ctx context.Context, p Params) iter.Seq2[*Session, error] {
+	return Paginate(ctx, p, c.GetSessionListContext)
 }
 
 // DeleteSession deletes the message session with
 // the given ID.
 func (c *Client) DeleteSession(id int) error {
-	return c.delete(sessionURI+"/"+strconv.Itoa(id), nil, nil, nil)
+	return c.DeleteSessionContext(context.Background(), id)
+}
+
+// DeleteSessionContext is the context-aware form of DeleteSession.
+func (c *Client) DeleteSessionContext(ctx context.Context, id int) error {
+	return c.delete(ctx, sessionURI+"/"+strconv.Itoa(id), nil, nil, nil)
 }
 
 // GetSessionMessages fetches messages bound by
@@ -383,7 +724,20 @@ func (c *Client) DeleteSession(id int) error {
 // - page:	Fetch specified results page.
 // - limit:	How many results on page.
 func (c *Client) GetSessionMessages(id int, p Params) (*MessageList, error) {
+	return c.GetSessionMessagesContext(context.Background(), id, p)
+}
+
+// GetSessionMessagesContext is the context-aware form of GetSessionMessages.
+func (c *Client) GetSessionMessagesContext(ctx context.Context, id int, p Params) (*MessageList, error) {
 	var l *MessageList
 
-	return l, c.get(sessionURI+"/"+strconv.Itoa(id)+"/messages", p, nil, &l)
+	return l, c.get(ctx, sessionURI+"/"+strconv.Itoa(id)+"/messages", p, nil, &l)
+}
+
+// AllSessionMessages returns an iterator over all messages
+// bound by the given session ID.
+func (c *Client) AllSessionMessages(ctx context.Context, id int, p Params) iter.Seq2[*Message, error] {
+	return Paginate(ctx, p, func(ctx context.Context, p Params) (*Page[*Message], error) {
+		return c.GetSessionMessagesContext(ctx, id, p)
+	})
 }
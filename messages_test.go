@@ -1,6 +1,7 @@
 package textmagic
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -191,7 +192,7 @@ func TestMessages(t *testing.T) {
 	assert.Equal(t, "Scheduled Go Test", scheduled.Session.Text)
 	assert.Equal(t, "A", scheduled.Session.Source)
 	assert.NotEmpty(t, scheduled.Session.ReferenceID)
-	assert.Equal(t, 0.0, scheduled.Session.Price)
+	assert.True(t, scheduled.Session.Price.IsZero())
 	assert.Equal(t, 1, scheduled.Session.NumbersCount)
 
 	time.Sleep(interval)
@@ -210,3 +211,50 @@ func TestMessages(t *testing.T) {
 
 	assert.Nil(t, err)
 }
+
+func TestMessageRequest(t *testing.T) {
+	sendingTime := time.Unix(1500000000, 0)
+
+	r := &MessageRequest{
+		Text:        "Hello",
+		SendingTime: sendingTime,
+		Contacts:    []int{1, 2},
+		Phones:      []string{"447860021130", "447860021131"},
+		CutExtra:    true,
+		PartsCount:  2,
+		Rrule:       "FREQ=WEEKLY;BYDAY=MO",
+	}
+
+	p, err := r.Params()
+
+	assert.Nil(t, err)
+	assert.Equal(t, Params{
+		"text":        "Hello",
+		"sendingTime": "1500000000",
+		"contacts":    "1,2",
+		"phones":      "447860021130,447860021131",
+		"cutExtra":    "1",
+		"partsCount":  "2",
+		"rrule":       "FREQ=WEEKLY;BYDAY=MO",
+	}, p)
+
+	// Validation
+
+	r = &MessageRequest{
+		Text:       "Hello",
+		TemplateID: 1,
+		PartsCount: 7,
+		Rrule:      "FREQ=DAILY",
+	}
+
+	_, err = r.Params()
+
+	assert.True(t, errors.Is(err, ErrValidation))
+	v, _ := err.(ValidationErrors)
+
+	assert.Len(t, v, 4)
+	assert.Contains(t, v, "templateId")
+	assert.Contains(t, v, "phones")
+	assert.Contains(t, v, "sendingTime")
+	assert.Contains(t, v, "partsCount")
+}
@@ -0,0 +1,266 @@
+package textmagic
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// moneyScale is the number of decimal places kept by Money.
+const moneyScale = 6
+
+var moneyUnit = int64(math.Pow10(moneyScale))
+
+// Money represents an exact decimal amount of money, such as
+// a price or balance, with up to six decimal places. Its
+// Currency is not part of API payloads and is nil unless set
+// with WithCurrency, usually to the account currency. User
+// balances are decoded with the user's currency attached.
+//
+// Amounts range to about ±9.2 trillion. Parsing rejects amounts
+// outside that range, while arithmetic overflows like int64.
+type Money struct {
+	units    int64
+	Currency *Currency
+}
+
+// ParseMoney parses a decimal amount such as "0.045".
+func ParseMoney(s string) (Money, error) {
+	u, err := parseUnits(s)
+
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{units: u}, nil
+}
+
+// WithCurrency returns a copy of m in the given currency.
+func (m Money) WithCurrency(c *Currency) Money {
+	m.Currency = c
+
+	return m
+}
+
+// Add returns the sum m + o, in the currency of m if set.
+func (m Money) Add(o Money) Money {
+	return Money{m.units + o.units, m.currency(o)}
+}
+
+// Sub returns the difference m - o, in the currency of m if set.
+func (m Money) Sub(o Money) Money {
+	return Money{m.units - o.units, m.currency(o)}
+}
+
+// Mul returns m multiplied by n. Products outside
+// the range of Money overflow, see Money.
+func (m Money) Mul(n int64) Money {
+	return Money{m.units * n, m.Currency}
+}
+
+// Neg returns -m.
+func (m Money) Neg() Money {
+	return Money{-m.units, m.Currency}
+}
+
+// Cmp compares m and o, returning -1, 0 or +1.
+func (m Money) Cmp(o Money) int {
+	switch {
+	case m.units < o.units:
+		return -1
+
+	case m.units > o.units:
+		return 1
+	}
+
+	return 0
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of m.
+func (m Money) Sign() int {
+	return m.Cmp(Money{})
+}
+
+// IsZero reports whether the amount is zero.
+func (m Money) IsZero() bool {
+	return m.units == 0
+}
+
+// Float64 returns the nearest float64 value of the amount.
+func (m Money) Float64() float64 {
+	return float64(m.units) / float64(moneyUnit)
+}
+
+// String returns the exact decimal amount, with at least
+// two decimal places, such as "12.50" or "0.045".
+func (m Money) String() string {
+	s := m.format(moneyScale)
+
+	for strings.HasSuffix(s, "0") && len(s)-strings.IndexByte(s, '.') > 3 {
+		s = s[:len(s)-1]
+	}
+
+	return s
+}
+
+// Format returns the amount rounded to the given number of
+// decimal places, prefixed by the currency symbol if set,
+// such as "£12.50".
+func (m Money) Format(places int) string {
+	s := m.format(places)
+
+	if m.Currency != nil && m.Currency.HTMLSymbol != "" {
+		sym := html.UnescapeString(m.Currency.HTMLSymbol)
+
+		if strings.HasPrefix(s, "-") {
+			return "-" + sym + s[1:]
+		}
+
+		return sym + s
+	}
+
+	return s
+}
+
+// MarshalJSON implements the json.Marshaler interface,
+// encoding the amount as an exact JSON number.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface,
+// decoding JSON numbers and numeric strings exactly.
+func (m *Money) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		return nil
+	}
+
+	s := string(b)
+
+	if len(b) > 0 && b[0] == '"' {
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+
+		if s == "" {
+			m.units = 0
+
+			return nil
+		}
+	}
+
+	u, err := parseUnits(s)
+
+	if err != nil {
+		return err
+	}
+
+	m.units = u
+
+	return nil
+}
+
+func (m Money) currency(o Money) *Currency {
+	if m.Currency != nil {
+		return m.Currency
+	}
+
+	return o.Currency
+}
+
+// format returns the amount rounded to the given number
+// of decimal places, at most moneyScale.
+func (m Money) format(places int) string {
+	if places > moneyScale {
+		places = moneyScale
+	} else if places < 0 {
+		places = 0
+	}
+
+	u, sign := m.units, ""
+
+	if u < 0 {
+		u, sign = -u, "-"
+	}
+
+	// Round half away from zero
+	d := int64(math.Pow10(moneyScale - places))
+	u = (u + d/2) / d * d
+
+	s := fmt.Sprintf("%s%d", sign, u/moneyUnit)
+
+	if places > 0 {
+		s += fmt.Sprintf(".%0*d", moneyScale, u%moneyUnit)[:places+1]
+	}
+
+	return s
+}
+
+// parseUnits parses a decimal string into millionths, rounding
+// half away from zero past the sixth decimal place.
+func parseUnits(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+
+	if strings.ContainsAny(s, "eE") {
+		f, err := strconv.ParseFloat(s, 64)
+
+		if err != nil {
+			return 0, fmt.Errorf("textmagic: invalid amount %q", s)
+		}
+
+		u := math.Round(f * float64(moneyUnit))
+
+		// float64(math.MaxInt64) rounds up to 2^63
+		if u >= math.MaxInt64 || u < math.MinInt64 {
+			return 0, fmt.Errorf("textmagic: amount %q out of range", s)
+		}
+
+		return int64(u), nil
+	}
+
+	v, neg := s, strings.HasPrefix(s, "-")
+
+	if neg || strings.HasPrefix(s, "+") {
+		v = v[1:]
+	}
+
+	i, f, _ := strings.Cut(v, ".")
+
+	if i == "" && f == "" {
+		return 0, fmt.Errorf("textmagic: invalid amount %q", s)
+	} else if i == "" {
+		i = "0"
+	}
+
+	round := len(f) > moneyScale && f[moneyScale] >= '5'
+
+	if len(f) > moneyScale {
+		f = f[:moneyScale]
+	}
+
+	f += strings.Repeat("0", moneyScale-len(f))
+
+	if strings.TrimLeft(i+f, "0123456789") != "" {
+		return 0, fmt.Errorf("textmagic: invalid amount %q", s)
+	}
+
+	u, err := strconv.ParseInt(i+f, 10, 64)
+
+	if err != nil {
+		return 0, fmt.Errorf("textmagic: invalid amount %q", s)
+	}
+
+	if round {
+		u++
+	}
+
+	if neg {
+		u = -u
+	}
+
+	return u, nil
+}
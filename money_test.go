@@ -0,0 +1,75 @@
+package textmagic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney(t *testing.T) {
+	var m Message
+
+	// Summing prices does not drift
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"price":0.045}`), &m))
+
+	total := Money{}
+
+	for i := 0; i < 10000; i++ {
+		total = total.Add(m.Price)
+	}
+
+	assert.Equal(t, "450.00", total.String())
+	assert.Equal(t, "0.045", m.Price.String())
+
+	// Parsing and rounding
+
+	for s, want := range map[string]string{
+		"12.5":       "12.50",
+		"-0.0000005": "-0.000001",
+		"1.2e-2":     "0.012",
+		"0":          "0.00",
+	} {
+		v, err := ParseMoney(s)
+
+		assert.Nil(t, err, s)
+		assert.Equal(t, want, v.String(), s)
+	}
+
+	for _, s := range []string{"1.2.3", "", " ", ".", "-", "--5", "+-5", "-+5", "1e20", "-1e20", "99999999999999"} {
+		_, err := ParseMoney(s)
+
+		assert.NotNil(t, err, s)
+	}
+
+	var u User
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"balance":"12.5","currency":{"id":"GBP","htmlSymbol":"&pound;"}}`), &u))
+	assert.Equal(t, "£12.50", u.Balance.Format(2))
+
+	var n AvailableNumbers
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"numbers":["447860021130"],"price":0.5}`), &n))
+	assert.Equal(t, "0.50", n.Price.String())
+
+	// Comparison and formatting
+
+	a, _ := ParseMoney("10.005")
+	b, _ := ParseMoney("2.5")
+	gbp := &Currency{ID: "GBP", HTMLSymbol: "&pound;"}
+
+	assert.Equal(t, 1, a.Cmp(b))
+	assert.Equal(t, -1, b.Sub(a).Sign())
+	assert.Equal(t, "£10.01", a.WithCurrency(gbp).Format(2))
+	assert.Equal(t, "-£7.51", b.WithCurrency(gbp).Sub(a).Format(2))
+	assert.Equal(t, "£5.00", b.Mul(2).WithCurrency(gbp).Format(2))
+
+	// Round trip
+
+	out, err := json.Marshal(a)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "10.005", string(out))
+	assert.Nil(t, json.Unmarshal([]byte(`{"balance":"123.4"}`), &User{}))
+}
@@ -1,6 +1,10 @@
 package textmagic
 
-import "strconv"
+import (
+	"context"
+	"iter"
+	"strconv"
+)
 
 const numberURI = "numbers"
 
@@ -18,35 +22,35 @@ type NewNumber struct {
 
 // Number represents a number.
 type Number struct {
-	ID          int      `json:"id"`
-	User        *User    `json:"user"`
-	PurchasedAt string   `json:"purchasedAt"`
-	ExpireAt    string   `json:"expireAt"`
-	Phone       string   `json:"phone"`
-	Country     *Country `json:"country"`
-	Status      string   `json:"status"`
+	ID          int          `json:"id"`
+	User        *User        `json:"user"`
+	PurchasedAt Time         `json:"purchasedAt"`
+	ExpireAt    Time         `json:"expireAt"`
+	Phone       string       `json:"phone"`
+	Country     *Country     `json:"country"`
+	Status      NumberStatus `json:"status"`
 }
 
 // NumberList represents a number list.
-type NumberList struct {
-	Page      int       `json:"page"`
-	Limit     int       `json:"limit"`
-	PageCount int       `json:"pageCount"`
-	Resources []*Number `json:"resources"`
-}
+type NumberList = Page[*Number]
 
 // AvailableNumbers represents available numbers.
 type AvailableNumbers struct {
 	Numbers []string `json:"numbers"`
-	Price   float64  `json:"price"`
+	Price   Money    `json:"price"`
 }
 
 // GetNumber gets a single dedicated number
 // for the given ID.
 func (c *Client) GetNumber(id int) (*Number, error) {
+	return c.GetNumberContext(context.Background(), id)
+}
+
+// GetNumberContext is the context-aware form of GetNumber.
+func (c *Client) GetNumberContext(ctx context.Context, id int) (*Number, error) {
 	var n *Number
 
-	return n, c.get(numberURI+"/"+strconv.Itoa(id), nil, nil, &n)
+	return n, c.get(ctx, numberURI+"/"+strconv.Itoa(id), nil, nil, &n)
 }
 
 // GetNumberList returns all user dedicated numbers.
@@ -55,9 +59,19 @@ func (c *Client) GetNumber(id int) (*Number, error) {
 // - page:	Fetch specified results page.
 // - limit:	How many results on page.
 func (c *Client) GetNumberList(p Params) (*NumberList, error) {
+	return c.GetNumberListContext(context.Background(), p)
+}
+
+// GetNumberListContext is the context-aware form of GetNumberList.
+func (c *Client) GetNumberListContext(ctx context.Context, p Params) (*NumberList, error) {
 	var l *NumberList
 
-	return l, c.get(numberURI, p, nil, &l)
+	return l, c.get(ctx, numberURI, p, nil, &l)
+}
+
+// AllNumbers returns an iterator over all user dedicated numbers.
+func (c *Client) AllNumbers(ctx context.Context, p Params) iter.Seq2[*Number, error] {
+	return Paginate(ctx, p, c.GetNumberListContext)
 }
 
 // BuyNumber buys a dedicated number and assigns
@@ -68,9 +82,14 @@ func (c *Client) GetNumberList(p Params) (*NumberList, error) {
 // - country: Dedicated number country. Required.
 // - userId:  User ID this number will be assigned to. Required.
 func (c *Client) BuyNumber(d Params) (*NewNumber, error) {
+	return c.BuyNumberContext(context.Background(), d)
+}
+
+// BuyNumberContext is the context-aware form of BuyNumber.
+func (c *Client) BuyNumberContext(ctx context.Context, d Params) (*NewNumber, error) {
 	var n *NewNumber
 
-	return n, c.post(numberURI, nil, d, &n)
+	return n, c.post(ctx, numberURI, nil, d, &n)
 }
 
 // GetAvailableNumbers finds available dedicated
@@ -80,13 +99,23 @@ func (c *Client) BuyNumber(d Params) (*NewNumber, error) {
 // - country: Dedicated number country. Required.
 // - prefix:  Desired number prefix. Should include country code (i.e. 447 for GB)
 func (c *Client) GetAvailableNumbers(p Params) (*AvailableNumbers, error) {
+	return c.GetAvailableNumbersContext(context.Background(), p)
+}
+
+// GetAvailableNumbersContext is the context-aware form of GetAvailableNumbers.
+func (c *Client) GetAvailableNumbersContext(ctx context.Context, p Params) (*AvailableNumbers, error) {
 	var n *AvailableNumbers
 
-	return n, c.get(numberURI+"/available", p, nil, &n)
+	return n, c.get(ctx, numberURI+"/available", p, nil, &n)
 }
 
 // CancelNumber cancels the dedicated number
 // subscription for the given ID.
 func (c *Client) CancelNumber(id int) error {
-	return c.delete(numberURI+"/"+strconv.Itoa(id), nil, nil, nil)
+	return c.CancelNumberContext(context.Background(), id)
+}
+
+// CancelNumberContext is the context-aware form of CancelNumber.
+func (c *Client) CancelNumberContext(ctx context.Context, id int) error {
+	return c.delete(ctx, numberURI+"/"+strconv.Itoa(id), nil, nil, nil)
 }
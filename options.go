@@ -0,0 +1,80 @@
+package textmagic
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used for API requests.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// WithTransport sets the round tripper used for API requests.
+// The client's HTTP client is copied first, so a client passed
+// to WithHTTPClient is never modified.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) {
+		hc := *c.httpClient
+		hc.Transport = rt
+		c.httpClient = &hc
+	}
+}
+
+// WithTimeout sets the time limit for API requests, including
+// connection time, redirects and reading the response body.
+// As with WithTransport, the HTTP client is copied first.
+// The default is 30 seconds, and zero means no limit.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		hc := *c.httpClient
+		hc.Timeout = d
+		c.httpClient = &hc
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with API requests.
+func WithUserAgent(ua string) Option {
+	return func(c *Client) {
+		c.userAgent = ua
+	}
+}
+
+// WithHeader adds a default header sent with every API request.
+// Authentication headers can not be overridden.
+func WithHeader(k, v string) Option {
+	return func(c *Client) {
+		c.header.Add(k, v)
+	}
+}
+
+// WithBaseURL sets the API base URL.
+func WithBaseURL(u string) Option {
+	return func(c *Client) {
+		c.baseURL = u
+	}
+}
+
+// WithJSONBody makes the client send request data as a JSON
+// body instead of form data. Typed inputs such as MessageRequest
+// are marshalled directly, so nested values are kept as is.
+func WithJSONBody() Option {
+	return func(c *Client) {
+		c.jsonBody = true
+	}
+}
+
+type jsonBodyKey struct{}
+
+// ContextWithJSONBody returns a copy of ctx which makes requests
+// made with it send their data as a JSON body, as WithJSONBody
+// does for all requests of a client.
+func ContextWithJSONBody(ctx context.Context) context.Context {
+	return context.WithValue(ctx, jsonBodyKey{}, true)
+}
@@ -0,0 +1,99 @@
+package textmagic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptions(t *testing.T) {
+	var header http.Header
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		w.Write([]byte(`{"ping":"pong"}`))
+	}))
+	defer srv.Close()
+
+	hc := &http.Client{}
+	c := NewClient("user", "key",
+		WithHTTPClient(hc),
+		WithTimeout(5*time.Second),
+		WithUserAgent("test-agent"),
+		WithHeader("X-Trace", "abc"),
+		WithBaseURL(srv.URL),
+	)
+
+	err := c.Ping()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "test-agent", header.Get("User-Agent"))
+	assert.Equal(t, "abc", header.Get("X-Trace"))
+	assert.Equal(t, "user", header.Get("X-TM-Username"))
+	assert.Equal(t, "key", header.Get("X-TM-Key"))
+
+	// The supplied client must not be modified.
+	assert.Equal(t, time.Duration(0), hc.Timeout)
+	assert.Equal(t, 5*time.Second, c.httpClient.Timeout)
+	assert.NotSame(t, NewClient("", "").httpClient, NewClient("", "").httpClient)
+
+	// Default timeout, overridden by a supplied client
+
+	assert.Equal(t, 30*time.Second, NewClient("", "").httpClient.Timeout)
+	assert.Same(t, hc, NewClient("", "", WithHTTPClient(hc)).httpClient)
+}
+
+func TestJSONBody(t *testing.T) {
+	var (
+		contentType string
+		body        map[string]interface{}
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		body = nil
+
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Write([]byte(`{"id":1,"href":"/api/v2/contacts/1"}`))
+	}))
+	defer srv.Close()
+
+	// Per client
+
+	c := NewClient("", "", WithBaseURL(srv.URL), WithJSONBody())
+
+	_, err := c.CreateContactFrom(&ContactInput{
+		Phone:        "447860021130",
+		Lists:        []int{1, 2},
+		CustomFields: map[int]string{7: "gold"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "application/json", contentType)
+	assert.Equal(t, map[string]interface{}{
+		"phone":        "447860021130",
+		"lists":        "1,2",
+		"customFields": map[string]interface{}{"7": "gold"},
+	}, body)
+
+	// Per call
+
+	c = NewClient("", "", WithBaseURL(srv.URL))
+	ctx := ContextWithJSONBody(context.Background())
+
+	_, err = c.CreateListContext(ctx, Params{"name": "Customers"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "application/json", contentType)
+	assert.Equal(t, map[string]interface{}{"name": "Customers"}, body)
+
+	_, err = c.CreateList(Params{"name": "Customers"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "application/x-www-form-urlencoded", contentType)
+}
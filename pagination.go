@@ -0,0 +1,174 @@
+package textmagic
+
+import (
+	"context"
+	"iter"
+	"strconv"
+)
+
+// Page represents a page of resources and
+// pagination information.
+type Page[T any] struct {
+	Page      int `json:"page"`
+	Limit     int `json:"limit"`
+	PageCount int `json:"pageCount"`
+	Resources []T `json:"resources"`
+}
+
+// PageFunc fetches a single page of resources
+// for the given parameters.
+type PageFunc[T any] func(ctx context.Context, p Params) (*Page[T], error)
+
+// Paginate returns an iterator over the resources of every
+// page returned by fetch, starting at the page set in p, or
+// the first page. Pages are fetched lazily as the iteration
+// proceeds and an error ends it.
+func Paginate[T any](ctx context.Context, p Params, fetch PageFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		q := p.clone()
+
+		for page := firstPage(q); ; page++ {
+			q.Set("page", page)
+
+			l, err := fetch(ctx, q)
+
+			if err != nil {
+				var zero T
+
+				yield(zero, err)
+
+				return
+			} else if l == nil {
+				return
+			}
+
+			for _, r := range l.Resources {
+				if !yield(r, nil) {
+					return
+				}
+			}
+
+			if len(l.Resources) == 0 || page >= l.PageCount {
+				return
+			}
+		}
+	}
+}
+
+// WalkPages is like Paginate, but once the first page reports
+// the page count, up to workers of the remaining pages are
+// fetched concurrently. Resources are still yielded in page
+// order, and ending the iteration early cancels the requests
+// in flight. Requests go through the client as usual, so they
+// are subject to its rate limiter.
+func WalkPages[T any](ctx context.Context, p Params, workers int, fetch PageFunc[T]) iter.Seq2[T, error] {
+	type result struct {
+		l   *Page[T]
+		err error
+	}
+
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		q := p.clone()
+		page := firstPage(q)
+		q.Set("page", page)
+
+		l, err := fetch(ctx, q)
+
+		if err != nil {
+			yield(zero, err)
+
+			return
+		} else if l == nil {
+			return
+		}
+
+		for _, r := range l.Resources {
+			if !yield(r, nil) {
+				return
+			}
+		}
+
+		if workers < 1 {
+			workers = 1
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var (
+			next    = page + 1
+			last    = l.PageCount
+			pending []chan result
+		)
+
+		launch := func() {
+			ch := make(chan result, 1)
+			q := p.clone()
+			q.Set("page", next)
+
+			go func() {
+				l, err := fetch(ctx, q)
+				ch <- result{l, err}
+			}()
+
+			pending = append(pending, ch)
+			next++
+		}
+
+		for len(pending) < workers && next <= last {
+			launch()
+		}
+
+		for len(pending) > 0 {
+			res := <-pending[0]
+			pending = pending[1:]
+
+			if next <= last {
+				launch()
+			}
+
+			if res.err != nil {
+				yield(zero, res.err)
+
+				return
+			} else if res.l == nil {
+				continue
+			}
+
+			for _, r := range res.l.Resources {
+				if !yield(r, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// CollectAll gathers the resources of the given iterator
+// into a slice, stopping at the first error.
+func CollectAll[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var s []T
+
+	for r, err := range seq {
+		if err != nil {
+			return s, err
+		}
+
+		s = append(s, r)
+	}
+
+	return s, nil
+}
+
+// firstPage returns the page set in p, or the first page.
+func firstPage(p Params) int {
+	page, err := strconv.Atoi(p["page"])
+
+	if err != nil || page < 1 {
+		return 1
+	}
+
+	return page
+}
@@ -0,0 +1,112 @@
+package textmagic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pageServer serves count contacts, limit per page.
+func pageServer(count, limit int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		pages := (count + limit - 1) / limit
+
+		fmt.Fprintf(w, `{"page":%d,"limit":%d,"pageCount":%d,"resources":[`, page, limit, pages)
+
+		for i := (page-1)*limit + 1; i <= page*limit && i <= count; i++ {
+			if i > (page-1)*limit+1 {
+				fmt.Fprint(w, ",")
+			}
+
+			fmt.Fprintf(w, `{"id":%d}`, i)
+		}
+
+		fmt.Fprint(w, "]}")
+	}))
+}
+
+func TestPaginate(t *testing.T) {
+	srv := pageServer(25, 10)
+	defer srv.Close()
+
+	c := NewClient("", "", WithBaseURL(srv.URL))
+
+	// Walk every page
+
+	contacts, err := CollectAll(c.AllContacts(context.Background(), nil))
+
+	assert.Nil(t, err)
+	assert.Len(t, contacts, 25)
+	assert.Equal(t, 1, contacts[0].ID)
+	assert.Equal(t, 25, contacts[24].ID)
+
+	// Start from the given page and stop early
+
+	var ids []int
+
+	for contact, err := range c.AllContacts(context.Background(), NewParams("page", 2)) {
+		assert.Nil(t, err)
+
+		if ids = append(ids, contact.ID); len(ids) == 3 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{11, 12, 13}, ids)
+
+	// Chat messages are fetched for the given phone
+
+	var paths []string
+
+	chat := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"page":1,"limit":10,"pageCount":1,"resources":[{"id":1}]}`))
+	}))
+	defer chat.Close()
+
+	c = NewClient("", "", WithBaseURL(chat.URL))
+
+	messages, err := CollectAll(c.AllChatMessages(context.Background(), "447860021130", nil))
+
+	assert.Nil(t, err)
+	assert.Len(t, messages, 1)
+	assert.Equal(t, []string{"/chats/447860021130"}, paths)
+}
+
+func TestWalkPages(t *testing.T) {
+	srv := pageServer(95, 10)
+	defer srv.Close()
+
+	c := NewClient("", "", WithBaseURL(srv.URL))
+
+	// Pages are reassembled in order
+
+	contacts, err := CollectAll(c.WalkContacts(context.Background(), nil, 4))
+
+	assert.Nil(t, err)
+	assert.Len(t, contacts, 95)
+
+	for i, contact := range contacts {
+		assert.Equal(t, i+1, contact.ID)
+	}
+
+	// Early termination
+
+	var n int
+
+	for _, err := range c.WalkContacts(context.Background(), nil, 4) {
+		assert.Nil(t, err)
+
+		if n++; n == 15 {
+			break
+		}
+	}
+
+	assert.Equal(t, 15, n)
+}
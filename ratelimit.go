@@ -0,0 +1,170 @@
+package textmagic
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket limiting the rate of API requests.
+// It is safe for concurrent use and may be shared between clients
+// of the same account.
+//
+// When a response reports the remaining account quota in the
+// X-RateLimit-Remaining header, the bucket is drained down to it,
+// and once the quota is exhausted requests are held back until
+// the X-RateLimit-Reset time.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	until  time.Time
+}
+
+// NewRateLimiter returns a RateLimiter allowing rps requests
+// per second on average, with bursts of up to burst requests.
+// When rps is not positive, only the first burst is allowed and
+// Wait blocks until its context is done.
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// WithRateLimit limits the client to rps requests per second
+// on average, with bursts of up to burst requests.
+func WithRateLimit(rps float64, burst int) Option {
+	return WithRateLimiter(NewRateLimiter(rps, burst))
+}
+
+// WithRateLimiter sets the rate limiter of the client, allowing
+// one limiter to be shared between several clients.
+func WithRateLimiter(l *RateLimiter) Option {
+	return func(c *Client) {
+		c.limiter = l
+	}
+}
+
+// WithRateLimitFailFast makes requests exceeding the rate limit
+// fail with ErrRateLimitExceeded instead of waiting.
+func WithRateLimitFailFast() Option {
+	return func(c *Client) {
+		c.limitFailFast = true
+	}
+}
+
+// Allow reports whether a request may be made now,
+// consuming a token if so.
+func (l *RateLimiter) Allow() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.advance()
+
+	if now.Before(l.until) || l.tokens < 1 {
+		return false
+	}
+
+	l.tokens--
+
+	return true
+}
+
+// Wait blocks until a request may be made or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+
+	now := l.advance()
+	l.tokens--
+
+	var d time.Duration
+
+	if l.tokens < 0 && l.rate <= 0 {
+		// No tokens are ever added back
+		d = time.Duration(1<<63 - 1)
+	} else if l.tokens < 0 {
+		d = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+
+	if w := l.until.Sub(now); w > d {
+		d = w
+	}
+
+	l.mu.Unlock()
+
+	if err := sleep(ctx, d); err != nil {
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+
+		return err
+	}
+
+	return nil
+}
+
+// advance refills the bucket for the time elapsed since the last
+// call and returns the current time. l.mu must be held.
+func (l *RateLimiter) advance() time.Time {
+	now := time.Now()
+
+	if l.tokens += now.Sub(l.last).Seconds() * l.rate; l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+
+	l.last = now
+
+	return now
+}
+
+// update adapts the bucket to the quota reported by the server.
+func (l *RateLimiter) update(h http.Header) {
+	n, err := strconv.Atoi(h.Get("X-RateLimit-Remaining"))
+
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.advance()
+
+	if float64(n) < l.tokens {
+		l.tokens = float64(n)
+	}
+
+	if n == 0 {
+		if d := rateLimitReset(h); d > 0 {
+			l.until = now.Add(d)
+		}
+	}
+}
+
+// limit applies the client rate limiter before a request.
+func (c *Client) limit(ctx context.Context) error {
+	if c.limiter == nil {
+		return nil
+	}
+
+	if c.limitFailFast {
+		if !c.limiter.Allow() {
+			return ErrRateLimitExceeded
+		}
+
+		return nil
+	}
+
+	return c.limiter.Wait(ctx)
+}
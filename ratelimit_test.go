@@ -0,0 +1,64 @@
+package textmagic
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	l := NewRateLimiter(1, 2)
+
+	assert.True(t, l.Allow())
+	assert.True(t, l.Allow())
+	assert.False(t, l.Allow())
+
+	// Wait honours the context deadline
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.Equal(t, context.DeadlineExceeded, l.Wait(ctx))
+
+	// Without a rate, only the burst is allowed in both modes
+
+	l = NewRateLimiter(0, 1)
+
+	assert.Nil(t, l.Wait(context.Background()))
+	assert.False(t, l.Allow())
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.Equal(t, context.DeadlineExceeded, l.Wait(ctx))
+
+	// Server reported quota
+
+	l = NewRateLimiter(100, 10)
+	l.update(http.Header{
+		"X-Ratelimit-Remaining": {"0"},
+		"X-Ratelimit-Reset":     {"60"},
+	})
+
+	assert.False(t, l.Allow())
+
+	// Fail fast client
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ping":"pong"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("", "", WithBaseURL(srv.URL), WithRateLimit(0.001, 1), WithRateLimitFailFast())
+
+	assert.Nil(t, c.Ping())
+
+	err := c.Ping()
+
+	assert.ErrorIs(t, err, ErrRateLimitExceeded)
+	assert.ErrorIs(t, err, ErrRateLimited)
+}
@@ -0,0 +1,250 @@
+package textmagic
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Checkpoint persists the high-water mark of a ReplyStream,
+// the ID up to which all replies were acknowledged.
+type Checkpoint interface {
+	Load(ctx context.Context) (int, error)
+	Save(ctx context.Context, id int) error
+}
+
+// MemoryCheckpoint is a Checkpoint kept in memory,
+// for use within a single process.
+type MemoryCheckpoint struct {
+	mu sync.Mutex
+	id int
+}
+
+// Load implements the Checkpoint interface.
+func (m *MemoryCheckpoint) Load(ctx context.Context) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.id, nil
+}
+
+// Save implements the Checkpoint interface.
+func (m *MemoryCheckpoint) Save(ctx context.Context, id int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.id = id
+
+	return nil
+}
+
+// ReplyStreamOptions configures a ReplyStream.
+type ReplyStreamOptions struct {
+	Interval   time.Duration // Delay between polls. Default is 10s.
+	Limit      int           // Replies per page. Default is 100.
+	Checkpoint Checkpoint    // Default is a new MemoryCheckpoint.
+
+	// Params, if set, holds search filters such as query,
+	// and replies are polled with SearchReplyList.
+	Params Params
+
+	// FromStart streams all existing replies when the checkpoint
+	// is empty. By default, only replies received after the first
+	// poll are streamed.
+	FromStart bool
+}
+
+// ReplyStream streams inbound replies by polling the reply list,
+// for environments which cannot receive callbacks.
+//
+// Replies are sent on the Replies channel in ID order, each once per
+// process. The checkpoint is advanced as replies are acknowledged
+// with Ack, so replies not acknowledged before a restart are sent
+// again by a stream using the same checkpoint.
+type ReplyStream struct {
+	client  *Client
+	opts    ReplyStreamOptions
+	replies chan *Reply
+
+	mu       sync.Mutex
+	mark     int          // Acknowledged high-water mark
+	seen     int          // Highest ID sent
+	inflight []int        // IDs sent, not yet covered by mark
+	acked    map[int]bool // Acknowledged IDs in inflight
+}
+
+// NewReplyStream returns a new ReplyStream for the client.
+// opts may be nil to use the defaults.
+func (c *Client) NewReplyStream(opts *ReplyStreamOptions) *ReplyStream {
+	s := &ReplyStream{
+		client:  c,
+		replies: make(chan *Reply),
+		acked:   map[int]bool{},
+	}
+
+	if opts != nil {
+		s.opts = *opts
+	}
+
+	if s.opts.Interval <= 0 {
+		s.opts.Interval = 10 * time.Second
+	}
+
+	if s.opts.Limit <= 0 {
+		s.opts.Limit = 100
+	}
+
+	if s.opts.Checkpoint == nil {
+		s.opts.Checkpoint = &MemoryCheckpoint{}
+	}
+
+	return s
+}
+
+// Replies returns the channel new replies are sent on.
+// It is closed when Run returns.
+func (s *ReplyStream) Replies() <-chan *Reply {
+	return s.replies
+}
+
+// Ack acknowledges the processing of the given reply, saving
+// the checkpoint once all earlier replies are acknowledged too.
+func (s *ReplyStream) Ack(ctx context.Context, r *Reply) error {
+	s.mu.Lock()
+
+	s.acked[r.ID] = true
+	mark := s.mark
+
+	for len(s.inflight) > 0 && s.acked[s.inflight[0]] {
+		mark = s.inflight[0]
+		delete(s.acked, mark)
+		s.inflight = s.inflight[1:]
+	}
+
+	if mark == s.mark {
+		s.mu.Unlock()
+
+		return nil
+	}
+
+	s.mark = mark
+	s.mu.Unlock()
+
+	return s.opts.Checkpoint.Save(ctx, mark)
+}
+
+// Run loads the checkpoint and polls for new replies until
+// ctx is done or a request fails, closing the Replies channel
+// when it returns.
+func (s *ReplyStream) Run(ctx context.Context) error {
+	defer close(s.replies)
+
+	mark, err := s.opts.Checkpoint.Load(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.mark, s.seen = mark, mark
+	s.mu.Unlock()
+
+	if mark == 0 && !s.opts.FromStart {
+		if err = s.skip(ctx); err != nil {
+			return err
+		}
+	}
+
+	for {
+		if err = s.poll(ctx); err != nil {
+			return err
+		}
+
+		if err = sleep(ctx, s.opts.Interval); err != nil {
+			return err
+		}
+	}
+}
+
+// skip moves the marks past the existing replies.
+func (s *ReplyStream) skip(ctx context.Context) error {
+	p := s.params()
+	p["limit"] = "1"
+
+	l, err := s.fetch(ctx, p)
+
+	if err != nil || l == nil || len(l.Resources) == 0 {
+		return err
+	}
+
+	id := l.Resources[0].ID
+
+	s.mu.Lock()
+	s.mark, s.seen = id, id
+	s.mu.Unlock()
+
+	return s.opts.Checkpoint.Save(ctx, id)
+}
+
+// poll fetches the replies newer than the last one sent,
+// newest first, and sends them in ID order.
+func (s *ReplyStream) poll(ctx context.Context) error {
+	s.mu.Lock()
+	seen := s.seen
+	s.mu.Unlock()
+
+	var (
+		fresh []*Reply
+		ids   = map[int]bool{}
+	)
+
+	for r, err := range Paginate(ctx, s.params(), s.fetch) {
+		if err != nil {
+			return err
+		} else if r.ID <= seen {
+			break
+		}
+
+		// Replies received while paging shift the pages
+		if !ids[r.ID] {
+			ids[r.ID] = true
+			fresh = append(fresh, r)
+		}
+	}
+
+	sort.Slice(fresh, func(i, j int) bool { return fresh[i].ID < fresh[j].ID })
+
+	for _, r := range fresh {
+		s.mu.Lock()
+		s.seen = r.ID
+		s.inflight = append(s.inflight, r.ID)
+		s.mu.Unlock()
+
+		select {
+		case s.replies <- r:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+func (s *ReplyStream) params() Params {
+	p := s.opts.Params.clone()
+	p["orderBy"] = "id"
+	p["direction"] = "desc"
+	p["limit"] = strconv.Itoa(s.opts.Limit)
+
+	return p
+}
+
+func (s *ReplyStream) fetch(ctx context.Context, p Params) (*ReplyList, error) {
+	if len(s.opts.Params) > 0 {
+		return s.client.SearchReplyListContext(ctx, p)
+	}
+
+	return s.client.GetReplyListContext(ctx, p, false)
+}
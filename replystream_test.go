@@ -0,0 +1,115 @@
+package textmagic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplyStream(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		last = 3
+	)
+
+	// Serves replies 1 to last, newest first
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		n := last
+		mu.Unlock()
+
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		page = max(page, 1)
+
+		var resources []string
+
+		for id := n - (page-1)*limit; id > 0 && id > n-page*limit; id-- {
+			resources = append(resources, fmt.Sprintf(`{"id":%d,"text":"reply %d"}`, id, id))
+		}
+
+		fmt.Fprintf(w, `{"page":%d,"limit":%d,"pageCount":%d,"resources":[%s]}`, page, limit, (n+limit-1)/limit, strings.Join(resources, ","))
+	}))
+	defer srv.Close()
+
+	c := NewClient("", "", WithBaseURL(srv.URL))
+	cp := &MemoryCheckpoint{}
+
+	run := func(fromStart bool) (*ReplyStream, context.CancelFunc, chan error) {
+		ctx, cancel := context.WithCancel(context.Background())
+		s := c.NewReplyStream(&ReplyStreamOptions{
+			Interval:   time.Millisecond,
+			Limit:      2,
+			Checkpoint: cp,
+			FromStart:  fromStart,
+		})
+
+		done := make(chan error, 1)
+
+		go func() { done <- s.Run(ctx) }()
+
+		return s, cancel, done
+	}
+
+	// Existing replies are skipped by default
+
+	s, cancel, done := run(false)
+
+	time.Sleep(20 * time.Millisecond)
+
+	mu.Lock()
+	last = 8
+	mu.Unlock()
+
+	var ids []int
+
+	for r := range s.Replies() {
+		ids = append(ids, r.ID)
+
+		// Reply 6 is not acknowledged
+		if r.ID != 6 {
+			assert.Nil(t, s.Ack(context.Background(), r))
+		}
+
+		if r.ID == 8 {
+			cancel()
+		}
+	}
+
+	assert.ErrorIs(t, <-done, context.Canceled)
+	assert.Equal(t, []int{4, 5, 6, 7, 8}, ids)
+
+	id, _ := cp.Load(context.Background())
+
+	assert.Equal(t, 5, id)
+
+	// A restarted stream sends the unacknowledged replies again
+
+	s, cancel, done = run(true)
+
+	ids = nil
+
+	for r := range s.Replies() {
+		ids = append(ids, r.ID)
+		assert.Nil(t, s.Ack(context.Background(), r))
+
+		if r.ID == 8 {
+			cancel()
+		}
+	}
+
+	assert.ErrorIs(t, <-done, context.Canceled)
+	assert.Equal(t, []int{6, 7, 8}, ids)
+
+	id, _ = cp.Load(context.Background())
+
+	assert.Equal(t, 8, id)
+}
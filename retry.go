@@ -0,0 +1,159 @@
+package textmagic
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DefaultRetryPolicy is the retry policy used by new clients.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  500 * time.Millisecond,
+	MaxBackoff:  30 * time.Second,
+}
+
+// RetryPolicy configures how requests failing with a network
+// error, a 429 or a 5xx response are retried.
+//
+// GET, PUT and DELETE requests are idempotent and retried
+// automatically. POST requests are only retried when they carry
+// a referenceId or the context has an idempotency key attached
+// with ContextWithIdempotencyKey.
+//
+// Between attempts the client waits for an exponentially growing,
+// jittered delay, or longer when the server asks for it with a
+// Retry-After or X-RateLimit-Reset header. When the server asks
+// for more than MaxBackoff, the error is returned instead.
+type RetryPolicy struct {
+	MaxAttempts int           // Total number of attempts, values below 2 disable retries.
+	MinBackoff  time.Duration // Delay before the first retry.
+	MaxBackoff  time.Duration // Upper bound of the delay between attempts.
+}
+
+// WithRetryPolicy sets the retry policy of the client.
+// Pass the zero RetryPolicy to disable retries.
+func WithRetryPolicy(r RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = r
+	}
+}
+
+type idempotencyKey struct{}
+
+// ContextWithIdempotencyKey returns a copy of ctx carrying the
+// given idempotency key. It is sent in the Idempotency-Key header
+// and marks POST requests made with the context as safe to retry.
+func ContextWithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKey{}, key)
+}
+
+// retryable reports whether the request may be retried.
+func (c *Client) retryable(ctx context.Context, method string, d Params) bool {
+	if c.retry.MaxAttempts < 2 {
+		return false
+	}
+
+	switch method {
+	case "GET", "HEAD", "PUT", "DELETE":
+		return true
+
+	case "POST":
+		if k, _ := ctx.Value(idempotencyKey{}).(string); k != "" {
+			return true
+		}
+
+		return d["referenceId"] != ""
+	}
+
+	return false
+}
+
+// backoff returns the jittered delay before the given retry.
+func (r RetryPolicy) backoff(attempt int) time.Duration {
+	d := r.MinBackoff
+
+	for i := 1; i < attempt && d < r.MaxBackoff; i++ {
+		d *= 2
+	}
+
+	if d > r.MaxBackoff {
+		d = r.MaxBackoff
+	}
+
+	if d <= 0 {
+		return 0
+	}
+
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+func retryStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// retryAfter returns the delay requested by the server via the
+// Retry-After header or, once the quota is exhausted, the
+// X-RateLimit-Reset header.
+func retryAfter(h http.Header) time.Duration {
+	if v := h.Get("Retry-After"); v != "" {
+		if s, err := strconv.Atoi(v); err == nil {
+			return time.Duration(s) * time.Second
+		}
+
+		if t, err := http.ParseTime(v); err == nil {
+			return time.Until(t)
+		}
+	}
+
+	if h.Get("X-RateLimit-Remaining") == "0" {
+		return rateLimitReset(h)
+	}
+
+	return 0
+}
+
+// rateLimitReset returns the time until the X-RateLimit-Reset
+// header, given either as a Unix timestamp or in seconds.
+func rateLimitReset(h http.Header) time.Duration {
+	v, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64)
+
+	if err != nil || v <= 0 {
+		return 0
+	}
+
+	if v > 1e9 {
+		return time.Until(time.Unix(v, 0))
+	}
+
+	return time.Duration(v) * time.Second
+}
+
+// sleep waits for the given duration or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,83 @@
+package textmagic
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetry(t *testing.T) {
+	var calls int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1)%2 == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"code":503,"message":"Service Unavailable"}`))
+
+			return
+		}
+
+		w.Write([]byte(`{"ping":"pong"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("", "", WithBaseURL(srv.URL), WithRetryPolicy(RetryPolicy{
+		MaxAttempts: 2,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  time.Millisecond,
+	}))
+
+	// GET is retried
+
+	assert.Nil(t, c.Ping())
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	// POST without idempotency key is not
+
+	atomic.StoreInt32(&calls, 0)
+	err := c.Request("POST", "ping", nil, Params{"text": "x"}, nil)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	// POST with referenceId is
+
+	atomic.StoreInt32(&calls, 0)
+	err = c.Request("POST", "ping", nil, Params{"referenceId": "x"}, &struct{}{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	// POST with idempotency key is
+
+	atomic.StoreInt32(&calls, 0)
+	ctx := ContextWithIdempotencyKey(context.Background(), "key")
+	err = c.RequestContext(ctx, "POST", "ping", nil, nil, &struct{}{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	// Waits beyond MaxBackoff return the error
+
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "86400")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer slow.Close()
+
+	atomic.StoreInt32(&calls, 0)
+	c = NewClient("", "", WithBaseURL(slow.URL))
+	start := time.Now()
+	err = c.Ping()
+
+	assert.ErrorIs(t, err, ErrRateLimited)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Less(t, time.Since(start), time.Second)
+}
@@ -0,0 +1,579 @@
+package textmagic
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxRRuleScan bounds the number of days, or hours for hourly
+// rules, scanned when enumerating occurrences.
+const maxRRuleScan = 366 * 100
+
+// Frequency represents the FREQ of a recurrence rule.
+type Frequency string
+
+// Recurrence rule frequencies.
+const (
+	FreqSecondly Frequency = "SECONDLY"
+	FreqMinutely Frequency = "MINUTELY"
+	FreqHourly   Frequency = "HOURLY"
+	FreqDaily    Frequency = "DAILY"
+	FreqWeekly   Frequency = "WEEKLY"
+	FreqMonthly  Frequency = "MONTHLY"
+	FreqYearly   Frequency = "YEARLY"
+)
+
+var weekdayCodes = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// WeekdayNum represents a BYDAY entry, such as MO, or -1FR for
+// the last Friday of the month when N is set.
+type WeekdayNum struct {
+	Weekday time.Weekday
+	N       int
+}
+
+// String returns the BYDAY entry.
+func (w WeekdayNum) String() string {
+	if w.N != 0 {
+		return strconv.Itoa(w.N) + weekdayCodes[w.Weekday]
+	}
+
+	return weekdayCodes[w.Weekday]
+}
+
+// RRule represents an iCal recurrence rule, as used by the rrule
+// parameter of CreateMessage and returned in Scheduled.Rrule.
+type RRule struct {
+	Freq       Frequency
+	Interval   int       // Periods between occurrences. Default is 1.
+	Count      int       // Number of occurrences. Default is unlimited.
+	Until      time.Time // Last possible occurrence.
+	ByDay      []WeekdayNum
+	ByMonthDay []int // Days of the month, negative from the month end.
+	ByMonth    []int
+	ByHour     []int
+	ByMinute   []int
+	BySetPos   []int  // Positions within the occurrences of each period.
+	WeekStart  string // First day of the week, such as SU. Default is MO.
+
+	// Extra holds the parts which are not interpreted, such as
+	// BYYEARDAY, as they appear in the rule. They are kept when the
+	// rule is formatted, but not supported by Occurrences.
+	Extra []string
+}
+
+// ParseRRule parses a recurrence rule such as
+// "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR".
+func ParseRRule(s string) (*RRule, error) {
+	r := &RRule{}
+
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(s), "RRULE:"), ";") {
+		if part == "" {
+			continue
+		}
+
+		k, v, ok := strings.Cut(part, "=")
+
+		if !ok {
+			return nil, fmt.Errorf("textmagic: invalid rrule part %q", part)
+		}
+
+		var err error
+
+		switch strings.ToUpper(k) {
+		case "FREQ":
+			r.Freq = Frequency(strings.ToUpper(v))
+
+			switch r.Freq {
+			case FreqSecondly, FreqMinutely, FreqHourly, FreqDaily, FreqWeekly, FreqMonthly, FreqYearly:
+			default:
+				err = fmt.Errorf("invalid frequency")
+			}
+
+		case "INTERVAL":
+			r.Interval, err = parsePositive(v)
+
+		case "COUNT":
+			r.Count, err = parsePositive(v)
+
+		case "UNTIL":
+			r.Until, err = parseRRuleTime(v)
+
+		case "BYDAY":
+			r.ByDay, err = parseWeekdays(v)
+
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseInts(v, -31, 31)
+
+		case "BYMONTH":
+			r.ByMonth, err = parseInts(v, 1, 12)
+
+		case "BYHOUR":
+			r.ByHour, err = parseInts(v, 0, 23)
+
+		case "BYMINUTE":
+			r.ByMinute, err = parseInts(v, 0, 59)
+
+		case "BYSETPOS":
+			r.BySetPos, err = parseInts(v, -366, 366)
+
+		case "WKST":
+			r.WeekStart = strings.ToUpper(v)
+
+			if weekday(r.WeekStart) < 0 {
+				err = fmt.Errorf("invalid weekday")
+			}
+
+		default:
+			r.Extra = append(r.Extra, part)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("textmagic: invalid rrule part %q: %v", part, err)
+		}
+	}
+
+	if r.Freq == "" {
+		return nil, fmt.Errorf("textmagic: rrule %q has no FREQ", s)
+	}
+
+	// Numbered weekdays only have a meaning within months and years
+	if r.Freq != FreqMonthly && r.Freq != FreqYearly {
+		for _, w := range r.ByDay {
+			if w.N != 0 {
+				return nil, fmt.Errorf("textmagic: rrule %q has numbered BYDAY %s with FREQ=%s", s, w, r.Freq)
+			}
+		}
+	}
+
+	return r, nil
+}
+
+// String returns the rule in the format expected by the API.
+func (r *RRule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+
+		for i, d := range r.ByDay {
+			days[i] = d.String()
+		}
+
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	for _, by := range []struct {
+		name string
+		v    []int
+	}{
+		{"BYMONTHDAY", r.ByMonthDay},
+		{"BYMONTH", r.ByMonth},
+		{"BYHOUR", r.ByHour},
+		{"BYMINUTE", r.ByMinute},
+		{"BYSETPOS", r.BySetPos},
+	} {
+		if len(by.v) > 0 {
+			parts = append(parts, by.name+"="+joinIntSlice(by.v))
+		}
+	}
+
+	if r.WeekStart != "" {
+		parts = append(parts, "WKST="+r.WeekStart)
+	}
+
+	return strings.Join(append(parts, r.Extra...), ";")
+}
+
+// Occurrences returns up to n occurrences of the rule after the
+// given time, for a schedule starting at start. Occurrences are
+// computed in the location of start.
+//
+// Rules with Extra parts or a frequency below HOURLY are not
+// supported and have no occurrences, see Scheduled.Upcoming.
+func (r *RRule) Occurrences(start, after time.Time, n int) []time.Time {
+	var (
+		out   []time.Time
+		count int
+	)
+
+	emit := func(t time.Time) bool {
+		if t.Before(start) {
+			return true
+		}
+
+		if !r.Until.IsZero() && t.After(r.Until) {
+			return false
+		}
+
+		if count++; r.Count > 0 && count > r.Count {
+			return false
+		}
+
+		if t.After(after) {
+			out = append(out, t)
+		}
+
+		return len(out) < n
+	}
+
+	if n <= 0 || r.unsupported() != nil {
+		return nil
+	}
+
+	var (
+		interval = max(r.Interval, 1)
+		batch    []time.Time
+		key      = -1
+	)
+
+	// Occurrences are collected per period, for BYSETPOS
+	// to select from.
+	flush := func(k int) bool {
+		if k == key {
+			return true
+		}
+
+		times := r.setPos(batch)
+		batch, key = nil, k
+
+		for _, t := range times {
+			if !emit(t) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if r.Freq == FreqHourly {
+		y, mo, d := start.Date()
+		first := time.Date(y, mo, d, start.Hour(), 0, 0, 0, start.Location())
+
+		for i := 0; i < maxRRuleScan*24; i += interval {
+			h := first.Add(time.Duration(i) * time.Hour)
+
+			if !flush(i) {
+				return out
+			}
+
+			if !r.matchDay(h, start) || !intIn(r.ByHour, h.Hour(), true) {
+				continue
+			}
+
+			for _, m := range r.minutes(start) {
+				batch = append(batch, h.Add(time.Duration(m)*time.Minute+time.Duration(start.Second())*time.Second))
+			}
+		}
+
+		flush(-1)
+
+		return out
+	}
+
+	y, mo, d := start.Date()
+
+	for i := 0; i < maxRRuleScan; i++ {
+		day := time.Date(y, mo, d+i, 0, 0, 0, 0, start.Location())
+		k := r.period(start, day)
+
+		if !flush(k) {
+			return out
+		}
+
+		if k%interval != 0 || !r.matchDay(day, start) {
+			continue
+		}
+
+		hours := r.ByHour
+
+		if len(hours) == 0 {
+			hours = []int{start.Hour()}
+		}
+
+		for _, h := range hours {
+			for _, m := range r.minutes(start) {
+				batch = append(batch, time.Date(day.Year(), day.Month(), day.Day(), h, m, start.Second(), 0, start.Location()))
+			}
+		}
+	}
+
+	flush(-1)
+
+	return out
+}
+
+// setPos sorts the occurrences of a period and
+// selects those at the BYSETPOS positions.
+func (r *RRule) setPos(times []time.Time) []time.Time {
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+	if len(r.BySetPos) == 0 {
+		return times
+	}
+
+	var out []time.Time
+
+	for i, t := range times {
+		for _, p := range r.BySetPos {
+			if p == i+1 || p == i-len(times) {
+				out = append(out, t)
+
+				break
+			}
+		}
+	}
+
+	return out
+}
+
+// period returns the number of rule periods between
+// the start and the given day.
+func (r *RRule) period(start, day time.Time) int {
+	switch r.Freq {
+	case FreqWeekly:
+		// Weeks start on Monday unless set by WKST
+		wkst := time.Monday
+
+		if r.WeekStart != "" {
+			wkst = weekday(r.WeekStart)
+		}
+
+		weekStart := func(t time.Time) time.Time {
+			return time.Date(t.Year(), t.Month(), t.Day()-(int(t.Weekday()-wkst)+7)%7, 0, 0, 0, 0, time.UTC)
+		}
+
+		return int(weekStart(day).Sub(weekStart(start)).Hours() / (24 * 7))
+
+	case FreqMonthly:
+		return (day.Year()-start.Year())*12 + int(day.Month()) - int(start.Month())
+
+	case FreqYearly:
+		return day.Year() - start.Year()
+	}
+
+	s := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	d := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+
+	return int(d.Sub(s).Hours() / 24)
+}
+
+// matchDay reports whether the day satisfies the BYMONTH,
+// BYMONTHDAY and BYDAY parts, or the default day implied
+// by the start for weekly, monthly and yearly rules.
+func (r *RRule) matchDay(day, start time.Time) bool {
+	if !intIn(r.ByMonth, int(day.Month()), true) {
+		return false
+	}
+
+	last := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	if len(r.ByMonthDay) > 0 {
+		if !intIn(r.ByMonthDay, day.Day(), false) && !intIn(r.ByMonthDay, day.Day()-last-1, false) {
+			return false
+		}
+	}
+
+	if len(r.ByDay) > 0 {
+		match := false
+
+		for _, w := range r.ByDay {
+			if w.Weekday != day.Weekday() {
+				continue
+			}
+
+			// Numbered weekdays count within the year for
+			// yearly rules without BYMONTH, else the month
+			n, count := day.Day(), last
+
+			if r.Freq == FreqYearly && len(r.ByMonth) == 0 {
+				n, count = day.YearDay(), time.Date(day.Year(), 12, 31, 0, 0, 0, 0, time.UTC).YearDay()
+			}
+
+			if w.N == 0 || w.N > 0 && (n-1)/7+1 == w.N || w.N < 0 && (count-n)/7+1 == -w.N {
+				match = true
+			}
+		}
+
+		return match
+	}
+
+	if len(r.ByMonthDay) > 0 {
+		return true
+	}
+
+	switch r.Freq {
+	case FreqWeekly:
+		return day.Weekday() == start.Weekday()
+
+	case FreqMonthly:
+		return day.Day() == start.Day()
+
+	case FreqYearly:
+		return day.Day() == start.Day() && (len(r.ByMonth) > 0 || day.Month() == start.Month())
+	}
+
+	return true
+}
+
+// unsupported returns an error for rules
+// Occurrences cannot enumerate.
+func (r *RRule) unsupported() error {
+	if r.Freq == FreqSecondly || r.Freq == FreqMinutely {
+		return fmt.Errorf("textmagic: rrule frequency %s is not supported", r.Freq)
+	} else if len(r.Extra) > 0 {
+		return fmt.Errorf("textmagic: rrule part %q is not supported", r.Extra[0])
+	}
+
+	return nil
+}
+
+func (r *RRule) minutes(start time.Time) []int {
+	if len(r.ByMinute) == 0 {
+		return []int{start.Minute()}
+	}
+
+	m := append([]int(nil), r.ByMinute...)
+	sort.Ints(m)
+
+	return m
+}
+
+// RRule parses the recurrence rule of the scheduled item.
+func (s *Scheduled) RRule() (*RRule, error) {
+	return ParseRRule(s.Rrule)
+}
+
+// Upcoming returns up to n upcoming send times of the scheduled
+// item in the given account timezone, starting with NextSend.
+// Any COUNT limit is applied from NextSend, as the API does not
+// report how many messages have been sent already. Rules which
+// Occurrences does not support return an error.
+func (s *Scheduled) Upcoming(n int, tz *Timezone) ([]time.Time, error) {
+	if s.Rrule == "" {
+		if s.NextSend.IsZero() || n < 1 {
+			return nil, nil
+		}
+
+		return []time.Time{s.NextSend.InZone(tz)}, nil
+	}
+
+	r, err := s.RRule()
+
+	if err != nil {
+		return nil, err
+	} else if err = r.unsupported(); err != nil {
+		return nil, err
+	}
+
+	start := s.NextSend.InZone(tz)
+
+	return r.Occurrences(start, start.Add(-time.Nanosecond), n), nil
+}
+
+func intIn(s []int, v int, emptyMatches bool) bool {
+	if len(s) == 0 {
+		return emptyMatches
+	}
+
+	for _, i := range s {
+		if i == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseInts(s string, lo, hi int) ([]int, error) {
+	var out []int
+
+	for _, f := range strings.Split(s, ",") {
+		i, err := strconv.Atoi(f)
+
+		if err != nil || i < lo || i > hi || i == 0 && lo < 0 {
+			return nil, fmt.Errorf("invalid value %q", f)
+		}
+
+		out = append(out, i)
+	}
+
+	return out, nil
+}
+
+func parsePositive(s string) (int, error) {
+	i, err := strconv.Atoi(s)
+
+	if err != nil || i < 1 {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+
+	return i, nil
+}
+
+func parseWeekdays(s string) ([]WeekdayNum, error) {
+	var out []WeekdayNum
+
+	for _, f := range strings.Split(s, ",") {
+		f = strings.ToUpper(f)
+
+		if len(f) < 2 {
+			return nil, fmt.Errorf("invalid weekday %q", f)
+		}
+
+		w := WeekdayNum{Weekday: weekday(f[len(f)-2:])}
+
+		if w.Weekday < 0 {
+			return nil, fmt.Errorf("invalid weekday %q", f)
+		}
+
+		if n := f[:len(f)-2]; n != "" {
+			var err error
+
+			if w.N, err = strconv.Atoi(n); err != nil || w.N == 0 || w.N < -53 || w.N > 53 {
+				return nil, fmt.Errorf("invalid weekday %q", f)
+			}
+		}
+
+		out = append(out, w)
+	}
+
+	return out, nil
+}
+
+// weekday returns the weekday for the given
+// code, such as MO, or -1 if invalid.
+func weekday(code string) time.Weekday {
+	for i, c := range weekdayCodes {
+		if c == code {
+			return time.Weekday(i)
+		}
+	}
+
+	return -1
+}
+
+func parseRRuleTime(s string) (time.Time, error) {
+	for _, l := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		if t, err := time.Parse(l, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q", s)
+}
@@ -0,0 +1,195 @@
+package textmagic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRRule(t *testing.T) {
+	r := &RRule{
+		Freq:     FreqMonthly,
+		Interval: 2,
+		Count:    4,
+		ByDay:    []WeekdayNum{{Weekday: time.Monday}, {Weekday: time.Friday, N: -1}},
+		ByHour:   []int{9},
+	}
+
+	s := r.String()
+
+	assert.Equal(t, "FREQ=MONTHLY;INTERVAL=2;COUNT=4;BYDAY=MO,-1FR;BYHOUR=9", s)
+
+	p, err := ParseRRule("RRULE:" + s)
+
+	assert.Nil(t, err)
+	assert.Equal(t, r, p)
+
+	p, err = ParseRRule("FREQ=DAILY;UNTIL=20250103T090000Z")
+
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2025, 1, 3, 9, 0, 0, 0, time.UTC), p.Until)
+
+	// Other parts round-trip
+
+	p, err = ParseRRule("FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO;WKST=SU")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "SU", p.WeekStart)
+	assert.Equal(t, []string{"BYWEEKNO=20"}, p.Extra)
+	assert.Equal(t, "FREQ=YEARLY;BYDAY=MO;WKST=SU;BYWEEKNO=20", p.String())
+	assert.Nil(t, p.Occurrences(time.Now(), time.Now(), 1))
+
+	for _, s := range []string{"", "INTERVAL=2", "FREQ=NEVER", "FREQ=DAILY;BYHOUR", "FREQ=DAILY;BYHOUR=24", "FREQ=DAILY;BYDAY=XX", "FREQ=DAILY;WKST=XX",
+		"FREQ=WEEKLY;BYDAY=MO,-1FR", "FREQ=DAILY;INTERVAL=-3", "FREQ=DAILY;INTERVAL=0", "FREQ=DAILY;COUNT=-2"} {
+		_, err = ParseRRule(s)
+
+		assert.NotNil(t, err, s)
+	}
+
+	// Occurrences
+
+	start := time.Date(2025, 1, 1, 9, 30, 0, 0, time.UTC) // Wednesday
+
+	format := func(ts []time.Time) []string {
+		s := make([]string, len(ts))
+
+		for i, t := range ts {
+			s[i] = t.Format("Mon 2006-01-02 15:04")
+		}
+
+		return s
+	}
+
+	p, _ = ParseRRule("FREQ=DAILY;INTERVAL=2;COUNT=3")
+
+	assert.Equal(t, []string{
+		"Wed 2025-01-01 09:30",
+		"Fri 2025-01-03 09:30",
+		"Sun 2025-01-05 09:30",
+	}, format(p.Occurrences(start, start.Add(-1), 10)))
+
+	p, _ = ParseRRule("FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=8,17;BYMINUTE=0")
+
+	assert.Equal(t, []string{
+		"Wed 2025-01-01 17:00",
+		"Mon 2025-01-06 08:00",
+		"Mon 2025-01-06 17:00",
+	}, format(p.Occurrences(start, start, 3)))
+
+	p, _ = ParseRRule("FREQ=MONTHLY;BYDAY=-1FR")
+
+	assert.Equal(t, []string{
+		"Fri 2025-01-31 09:30",
+		"Fri 2025-02-28 09:30",
+	}, format(p.Occurrences(start, start, 2)))
+
+	p, _ = ParseRRule("FREQ=MONTHLY;BYMONTHDAY=-1;UNTIL=20250401")
+
+	assert.Equal(t, []string{
+		"Fri 2025-01-31 09:30",
+		"Fri 2025-02-28 09:30",
+		"Mon 2025-03-31 09:30",
+	}, format(p.Occurrences(start, start, 10)))
+
+	// Yearly numbered weekdays count within the year,
+	// or within the month with BYMONTH
+
+	p, _ = ParseRRule("FREQ=YEARLY;BYDAY=-1MO")
+
+	assert.Equal(t, []string{
+		"Mon 2025-12-29 09:30",
+		"Mon 2026-12-28 09:30",
+	}, format(p.Occurrences(start, start, 2)))
+
+	p, _ = ParseRRule("FREQ=YEARLY;BYDAY=20MO")
+
+	assert.Equal(t, []string{
+		"Mon 2025-05-19 09:30",
+		"Mon 2026-05-18 09:30",
+	}, format(p.Occurrences(start, start, 2)))
+
+	p, _ = ParseRRule("FREQ=YEARLY;BYMONTH=2;BYDAY=-1MO")
+
+	assert.Equal(t, []string{
+		"Mon 2025-02-24 09:30",
+		"Mon 2026-02-23 09:30",
+	}, format(p.Occurrences(start, start, 2)))
+
+	p, _ = ParseRRule("FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1")
+
+	assert.Equal(t, []string{
+		"Fri 2025-01-31 09:30",
+		"Fri 2025-02-28 09:30",
+		"Mon 2025-03-31 09:30",
+	}, format(p.Occurrences(start, start, 3)))
+
+	// Sunday 5th is in the first week starting on Monday,
+	// but in the skipped second week starting on Sunday
+
+	p, _ = ParseRRule("FREQ=WEEKLY;INTERVAL=2;BYDAY=WE,SU")
+
+	assert.Equal(t, []string{
+		"Wed 2025-01-01 09:30",
+		"Sun 2025-01-05 09:30",
+	}, format(p.Occurrences(start, start.Add(-1), 2)))
+
+	p.WeekStart = "SU"
+
+	assert.Equal(t, []string{
+		"Wed 2025-01-01 09:30",
+		"Sun 2025-01-12 09:30",
+	}, format(p.Occurrences(start, start.Add(-1), 2)))
+
+	p, _ = ParseRRule("FREQ=HOURLY;INTERVAL=6;COUNT=3")
+
+	assert.Equal(t, []string{
+		"Wed 2025-01-01 09:30",
+		"Wed 2025-01-01 15:30",
+		"Wed 2025-01-01 21:30",
+	}, format(p.Occurrences(start, start.Add(-1), 10)))
+
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+
+	assert.Nil(t, err)
+
+	p, _ = ParseRRule("FREQ=HOURLY;COUNT=2")
+	at := time.Date(2025, 1, 1, 10, 15, 0, 0, kolkata)
+
+	assert.Equal(t, []string{
+		"Wed 2025-01-01 10:15",
+		"Wed 2025-01-01 11:15",
+	}, format(p.Occurrences(at, at.Add(-1), 10)))
+
+	// Scheduled items
+
+	sched := &Scheduled{
+		NextSend: Time{Time: start},
+		Rrule:    "FREQ=YEARLY",
+	}
+
+	tz := &Timezone{Timezone: "Asia/Tokyo"}
+
+	next, err := sched.Upcoming(2, tz)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"Wed 2025-01-01 18:30",
+		"Thu 2026-01-01 18:30",
+	}, format(next))
+
+	// Invalid rules are caught before sending
+
+	_, err = (&MessageRequest{Text: "Hi", Phones: []string{"447860021130"}, SendingTime: start, Rrule: "FREQ=NEVER"}).Params()
+
+	assert.ErrorIs(t, err, ErrValidation)
+
+	_, err = (&MessageRequest{Text: "Hi", Phones: []string{"447860021130"}, SendingTime: start, Rrule: "FREQ=WEEKLY;WKST=SU;BYDAY=MO"}).Params()
+
+	assert.Nil(t, err)
+
+	sched.Rrule = "FREQ=DAILY;BYYEARDAY=1"
+	_, err = sched.Upcoming(2, tz)
+
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,151 @@
+package textmagic
+
+import (
+	"context"
+	"iter"
+	"strconv"
+	"time"
+)
+
+// Recipients represents the recipients of a scheduled message.
+type Recipients struct {
+	Contacts []int    `json:"contacts"`
+	Lists    []int    `json:"lists"`
+	Phones   []string `json:"phones"`
+}
+
+// Len returns the number of contacts, lists
+// and phone numbers of the recipients.
+func (r *Recipients) Len() int {
+	if r == nil {
+		return 0
+	}
+
+	return len(r.Contacts) + len(r.Lists) + len(r.Phones)
+}
+
+// CreateScheduled schedules a new message. It is like
+// CreateMessageFrom, but requires SendingTime to be set.
+// The ID of the new scheduled item is returned in ScheduleID.
+func (c *Client) CreateScheduled(r *MessageRequest) (*NewMessage, error) {
+	return c.CreateScheduledContext(context.Background(), r)
+}
+
+// CreateScheduledContext is the context-aware form of CreateScheduled.
+func (c *Client) CreateScheduledContext(ctx context.Context, r *MessageRequest) (*NewMessage, error) {
+	if r.SendingTime.IsZero() {
+		v := ValidationErrors{}
+		v.Add("sendingTime", "sendingTime is required for scheduled messages")
+
+		return nil, v
+	}
+
+	return c.CreateMessageFromContext(ctx, r)
+}
+
+// UpdateScheduled updates the scheduled item with the given
+// ID with the corresponding POST DATA.
+//
+// The data payload includes the CreateMessage fields:
+// - text:			Message text.
+// - templateId:	Template used instead of message text.
+// - sendingTime:	Message sending time in unix timestamp format.
+// - contacts:		Contacts IDs the message will be sent to.
+// - lists:			Lists IDs the message will be sent to.
+// - phones:		Phone numbers the message will be sent to.
+// - from:			One of allowed Sender ID.
+// - rrule:			iCal RRULE parameter of recurrent scheduled messages.
+func (c *Client) UpdateScheduled(id int, d Params) (*NewMessage, error) {
+	return c.UpdateScheduledContext(context.Background(), id, d)
+}
+
+// UpdateScheduledContext is the context-aware form of UpdateScheduled.
+func (c *Client) UpdateScheduledContext(ctx context.Context, id int, d Params) (*NewMessage, error) {
+	var m *NewMessage
+
+	return m, c.put(ctx, scheduledURI+"/"+strconv.Itoa(id), nil, d, &m)
+}
+
+// UpdateScheduledFrom is like UpdateScheduled, but takes a typed
+// request which is validated before it is sent.
+func (c *Client) UpdateScheduledFrom(id int, r *MessageRequest) (*NewMessage, error) {
+	return c.UpdateScheduledFromContext(context.Background(), id, r)
+}
+
+// UpdateScheduledFromContext is the context-aware form of UpdateScheduledFrom.
+func (c *Client) UpdateScheduledFromContext(ctx context.Context, id int, r *MessageRequest) (*NewMessage, error) {
+	d, err := r.Params()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var m *NewMessage
+
+	return m, c.request(ctx, "PUT", scheduledURI+"/"+strconv.Itoa(id), nil, d, r, &m)
+}
+
+// RescheduleMessage moves the scheduled item with the given ID
+// to a new sending time, keeping its text and recipients.
+func (c *Client) RescheduleMessage(id int, at time.Time) (*NewMessage, error) {
+	return c.RescheduleMessageContext(context.Background(), id, at)
+}
+
+// RescheduleMessageContext is the context-aware form of RescheduleMessage.
+func (c *Client) RescheduleMessageContext(ctx context.Context, id int, at time.Time) (*NewMessage, error) {
+	if at.IsZero() {
+		v := ValidationErrors{}
+		v.Add("sendingTime", "sendingTime is required")
+
+		return nil, v
+	}
+
+	return c.UpdateScheduledContext(ctx, id, NewParams("sendingTime", at))
+}
+
+// PauseScheduled pauses the scheduled item with the given
+// ID. Paused items are not sent until resumed.
+func (c *Client) PauseScheduled(id int) error {
+	return c.PauseScheduledContext(context.Background(), id)
+}
+
+// PauseScheduledContext is the context-aware form of PauseScheduled.
+func (c *Client) PauseScheduledContext(ctx context.Context, id int) error {
+	return c.put(ctx, scheduledURI+"/"+strconv.Itoa(id)+"/pause", nil, nil, nil)
+}
+
+// ResumeScheduled resumes the paused scheduled item
+// with the given ID.
+func (c *Client) ResumeScheduled(id int) error {
+	return c.ResumeScheduledContext(context.Background(), id)
+}
+
+// ResumeScheduledContext is the context-aware form of ResumeScheduled.
+func (c *Client) ResumeScheduledContext(ctx context.Context, id int) error {
+	return c.put(ctx, scheduledURI+"/"+strconv.Itoa(id)+"/resume", nil, nil, nil)
+}
+
+// SearchScheduledList returns user scheduled messages
+// in relation to search filters.
+//
+// The parameter payload includes:
+// - page:	Fetch specified results page.
+// - limit:	How many results on page.
+// - ids:	Find scheduled items by ID(s).
+// - query:	Find scheduled items by specified search query.
+func (c *Client) SearchScheduledList(p Params) (*ScheduledList, error) {
+	return c.SearchScheduledListContext(context.Background(), p)
+}
+
+// SearchScheduledListContext is the context-aware form of SearchScheduledList.
+func (c *Client) SearchScheduledListContext(ctx context.Context, p Params) (*ScheduledList, error) {
+	var l *ScheduledList
+
+	return l, c.get(ctx, scheduledURI+"/search", p, nil, &l)
+}
+
+// SearchAllScheduled returns an iterator over all user scheduled
+// messages in relation to search filters.
+func (c *Client) SearchAllScheduled(ctx context.Context, p Params) iter.Seq2[*Scheduled, error] {
+	return Paginate(ctx, p, c.SearchScheduledListContext)
+}
@@ -0,0 +1,74 @@
+package textmagic
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduled(t *testing.T) {
+	var requests []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.RequestURI()+" "+string(b))
+
+		switch r.URL.Path {
+		case "/schedules/search":
+			w.Write([]byte(`{"page":1,"pageCount":1,"limit":10,"resources":[{"id":7,"nextSend":"2025-01-01T09:30:00+0000","paused":true,"recipients":{"contacts":[1],"phones":["447860021130"]}}]}`))
+
+		case "/schedules/7/pause":
+			w.Write([]byte(`{"id":7,"href":"/api/v2/schedules/7"}`))
+
+		case "/schedules/7/resume":
+			w.WriteHeader(http.StatusNoContent)
+
+		default:
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":7,"href":"/api/v2/schedules/7"}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient("", "", WithBaseURL(srv.URL))
+
+	// Sending time is required
+
+	_, err := c.CreateScheduled(&MessageRequest{Text: "Hi", Phones: []string{"447860021130"}})
+
+	assert.ErrorIs(t, err, ErrValidation)
+	assert.Empty(t, requests)
+
+	at := time.Unix(1735723800, 0)
+
+	m, err := c.RescheduleMessage(7, at)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 7, m.ID)
+
+	assert.Nil(t, c.PauseScheduled(7))
+	assert.Nil(t, c.ResumeScheduled(7))
+
+	l, err := c.SearchScheduledList(Params{"query": "x"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(l.Resources))
+
+	s := l.Resources[0]
+
+	assert.True(t, s.Paused)
+	assert.Equal(t, 2, s.Recipients.Len())
+	assert.Equal(t, []string{"447860021130"}, s.Recipients.Phones)
+	assert.True(t, s.NextSend.Equal(at))
+
+	assert.Equal(t, []string{
+		"PUT /schedules/7 sendingTime=1735723800",
+		"PUT /schedules/7/pause ",
+		"PUT /schedules/7/resume ",
+		"GET /schedules/search?query=x ",
+	}, requests)
+}
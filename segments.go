@@ -0,0 +1,179 @@
+package textmagic
+
+import "unicode/utf16"
+
+const (
+	gsmBasic    = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+	gsmExtended = "\f^{}\\[~]|€"
+)
+
+var (
+	gsmBasicSet    = runeSet(gsmBasic)
+	gsmExtendedSet = runeSet(gsmExtended)
+)
+
+// Encoding represents the character encoding of an SMS.
+type Encoding int
+
+// SMS character encodings.
+const (
+	GSM7 Encoding = iota // GSM 03.38 7-bit default alphabet.
+	UCS2                 // UCS-2, used when the text has other characters.
+)
+
+// String returns the name of the encoding.
+func (e Encoding) String() string {
+	if e == UCS2 {
+		return "UCS-2"
+	}
+
+	return "GSM-7"
+}
+
+// single and multi return the capacity of a single part
+// message and of each part of a concatenated message,
+// the rest being taken by the concatenation header.
+func (e Encoding) single() int {
+	if e == UCS2 {
+		return 70
+	}
+
+	return 160
+}
+
+func (e Encoding) multi() int {
+	if e == UCS2 {
+		return 67
+	}
+
+	return 153
+}
+
+// width returns the number of encoding units taken by r:
+// septets for GSM-7 and UTF-16 code units for UCS-2.
+func (e Encoding) width(r rune) int {
+	if e == UCS2 {
+		return utf16.RuneLen(r)
+	}
+
+	if _, ok := gsmExtendedSet[r]; ok {
+		return 2
+	}
+
+	return 1
+}
+
+// Segments describes how a message text is split into SMS parts.
+type Segments struct {
+	Encoding  Encoding
+	Length    int    // Text length in encoding units.
+	Parts     int    // Number of message parts.
+	PerPart   int    // Capacity of each part in encoding units.
+	Remaining int    // Encoding units left in the last part.
+	Unicode   []rune // Characters which forced UCS-2, in order of appearance.
+}
+
+// CountSegments computes the encoding and number of parts of
+// the given message text, without contacting the API.
+//
+// GSM-7 extended table characters, such as '€', count double
+// and UCS-2 characters outside the Basic Multilingual Plane
+// count as two. Neither is ever split between parts.
+func CountSegments(text string) *Segments {
+	s := &Segments{Encoding: GSM7}
+
+	seen := map[rune]bool{}
+
+	for _, r := range text {
+		if !isGSM(r) {
+			s.Encoding = UCS2
+
+			if !seen[r] {
+				seen[r] = true
+				s.Unicode = append(s.Unicode, r)
+			}
+		}
+	}
+
+	for _, r := range text {
+		s.Length += s.Encoding.width(r)
+	}
+
+	if s.Length <= s.Encoding.single() {
+		s.PerPart = s.Encoding.single()
+
+		if s.Length > 0 {
+			s.Parts = 1
+		}
+
+		s.Remaining = s.PerPart - s.Length
+
+		return s
+	}
+
+	s.PerPart = s.Encoding.multi()
+	s.Parts, s.Remaining = 1, s.PerPart
+
+	for _, r := range text {
+		w := s.Encoding.width(r)
+
+		if w > s.Remaining {
+			s.Parts++
+			s.Remaining = s.PerPart
+		}
+
+		s.Remaining -= w
+	}
+
+	return s
+}
+
+// TruncateToParts cuts text to fit into the given number of
+// parts, as CreateMessage does with cutExtra set. The encoding
+// is chosen for the whole text, before cutting it.
+func TruncateToParts(text string, parts int) string {
+	if parts < 1 {
+		return ""
+	}
+
+	s := CountSegments(text)
+
+	if s.Parts <= parts {
+		return text
+	}
+
+	capacity, n := s.Encoding.single(), 1
+
+	if parts > 1 {
+		capacity = s.Encoding.multi()
+	}
+
+	left := capacity
+
+	for i, r := range text {
+		w := s.Encoding.width(r)
+
+		if w > left {
+			if n == parts {
+				return text[:i]
+			}
+
+			n++
+			left = capacity
+		}
+
+		left -= w
+	}
+
+	return text
+}
+
+func isGSM(r rune) bool {
+	if _, ok := gsmBasicSet[r]; ok {
+		return true
+	}
+
+	_, ok := gsmExtendedSet[r]
+
+	return ok
+}
@@ -0,0 +1,63 @@
+package textmagic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegments(t *testing.T) {
+	// GSM-7
+
+	s := CountSegments(strings.Repeat("a", 160))
+
+	assert.Equal(t, GSM7, s.Encoding)
+	assert.Equal(t, 1, s.Parts)
+	assert.Equal(t, 0, s.Remaining)
+
+	s = CountSegments(strings.Repeat("a", 161))
+
+	assert.Equal(t, 2, s.Parts)
+	assert.Equal(t, 153, s.PerPart)
+	assert.Equal(t, 145, s.Remaining)
+
+	// Extended characters count double and are not split
+
+	s = CountSegments(strings.Repeat("a", 152) + "€")
+
+	assert.Equal(t, 154, s.Length)
+	assert.Equal(t, 1, s.Parts)
+
+	s = CountSegments(strings.Repeat("a", 152) + "€" + strings.Repeat("a", 10))
+
+	assert.Equal(t, 164, s.Length)
+	assert.Equal(t, 2, s.Parts)
+	assert.Equal(t, 153-12, s.Remaining)
+
+	// UCS-2
+
+	s = CountSegments("Hello “world” — ok “again”")
+
+	assert.Equal(t, UCS2, s.Encoding)
+	assert.Equal(t, []rune{'“', '”', '—'}, s.Unicode)
+	assert.Equal(t, 1, s.Parts)
+
+	s = CountSegments(strings.Repeat("ж", 71))
+
+	assert.Equal(t, 2, s.Parts)
+	assert.Equal(t, 67, s.PerPart)
+
+	s = CountSegments("")
+
+	assert.Equal(t, 0, s.Parts)
+
+	// Truncation
+
+	text := strings.Repeat("a", 400)
+
+	assert.Equal(t, 160, len(TruncateToParts(text, 1)))
+	assert.Equal(t, 306, len(TruncateToParts(text, 2)))
+	assert.Equal(t, text, TruncateToParts(text, 3))
+	assert.Equal(t, strings.Repeat("a", 159), TruncateToParts(strings.Repeat("a", 159)+"€b", 1))
+}
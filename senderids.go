@@ -1,6 +1,10 @@
 package textmagic
 
-import "strconv"
+import (
+	"context"
+	"iter"
+	"strconv"
+)
 
 const (
 	senderIDURI = "senderids"
@@ -15,19 +19,14 @@ type NewSenderID struct {
 
 // SenderID represents a sender ID.
 type SenderID struct {
-	ID       int    `json:"id"`
-	SenderID string `json:"senderId"`
-	User     *User  `json:"user"`
-	Status   string `json:"status"`
+	ID       int            `json:"id"`
+	SenderID string         `json:"senderId"`
+	User     *User          `json:"user"`
+	Status   SenderIDStatus `json:"status"`
 }
 
 // SenderIDList  represents a sender ID list.
-type SenderIDList struct {
-	Page      int         `json:"page"`
-	Limit     int         `json:"limit"`
-	PageCount int         `json:"pageCount"`
-	Resources []*SenderID `json:"resources"`
-}
+type SenderIDList = Page[*SenderID]
 
 // Sources represents sources.
 type Sources struct {
@@ -40,9 +39,14 @@ type Sources struct {
 // GetSenderID returns a single sender ID
 // for the give numeric ID.
 func (c *Client) GetSenderID(id int) (*SenderID, error) {
+	return c.GetSenderIDContext(context.Background(), id)
+}
+
+// GetSenderIDContext is the context-aware form of GetSenderID.
+func (c *Client) GetSenderIDContext(ctx context.Context, id int) (*SenderID, error) {
 	var s *SenderID
 
-	return s, c.get(senderIDURI+"/"+strconv.Itoa(id), nil, nil, &s)
+	return s, c.get(ctx, senderIDURI+"/"+strconv.Itoa(id), nil, nil, &s)
 }
 
 // GetSenderIDList returns all user sender IDs.
@@ -51,9 +55,19 @@ func (c *Client) GetSenderID(id int) (*SenderID, error) {
 // - page:	Fetch specified results page.
 // - limit:	How many results on page.
 func (c *Client) GetSenderIDList(p Params) (*SenderIDList, error) {
+	return c.GetSenderIDListContext(context.Background(), p)
+}
+
+// GetSenderIDListContext is the context-aware form of GetSenderIDList.
+func (c *Client) GetSenderIDListContext(ctx context.Context, p Params) (*SenderIDList, error) {
 	var l *SenderIDList
 
-	return l, c.get(senderIDURI, p, nil, &l)
+	return l, c.get(ctx, senderIDURI, p, nil, &l)
+}
+
+// AllSenderIDs returns an iterator over all user sender IDs.
+func (c *Client) AllSenderIDs(ctx context.Context, p Params) iter.Seq2[*SenderID, error] {
+	return Paginate(ctx, p, c.GetSenderIDListContext)
 }
 
 // CreateSenderID creates a new sender ID.
@@ -62,14 +76,24 @@ func (c *Client) GetSenderIDList(p Params) (*SenderIDList, error) {
 // - senderId:		Alphanumeric Sender ID (maximum 11 characters). Required.
 // - explanation:	Explain why do you need this Sender ID. Required.
 func (c *Client) CreateSenderID(d Params) (*NewSenderID, error) {
+	return c.CreateSenderIDContext(context.Background(), d)
+}
+
+// CreateSenderIDContext is the context-aware form of CreateSenderID.
+func (c *Client) CreateSenderIDContext(ctx context.Context, d Params) (*NewSenderID, error) {
 	var s *NewSenderID
 
-	return s, c.post(senderIDURI, nil, d, &s)
+	return s, c.post(ctx, senderIDURI, nil, d, &s)
 }
 
 // DeleteSenderID deletes the given sender ID.
 func (c *Client) DeleteSenderID(id int) error {
-	return c.delete(senderIDURI+"/"+strconv.Itoa(id), nil, nil, nil)
+	return c.DeleteSenderIDContext(context.Background(), id)
+}
+
+// DeleteSenderIDContext is the context-aware form of DeleteSenderID.
+func (c *Client) DeleteSenderIDContext(ctx context.Context, id int) error {
+	return c.delete(ctx, senderIDURI+"/"+strconv.Itoa(id), nil, nil, nil)
 }
 
 // GetSources returns all available sender settings which
@@ -78,7 +102,12 @@ func (c *Client) DeleteSenderID(id int) error {
 // The parameter payload includes:
 // - country:	Return sender settings available in specified country only. Optional.
 func (c *Client) GetSources(p Params) (*Sources, error) {
+	return c.GetSourcesContext(context.Background(), p)
+}
+
+// GetSourcesContext is the context-aware form of GetSources.
+func (c *Client) GetSourcesContext(ctx context.Context, p Params) (*Sources, error) {
 	var s *Sources
 
-	return s, c.get(sourceURI, p, nil, &s)
+	return s, c.get(ctx, sourceURI, p, nil, &s)
 }
@@ -0,0 +1,229 @@
+package textmagic
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// MessageStatus represents the delivery status of a message.
+// Values unknown to this package are kept as received.
+type MessageStatus string
+
+// Message delivery statuses.
+const (
+	MessageQueued    MessageStatus = "q" // Queued on the TextMagic server.
+	MessageSent      MessageStatus = "s" // Sent to the mobile operator.
+	MessageSendError MessageStatus = "e" // Error sending to the mobile operator.
+	MessageEnroute   MessageStatus = "r" // On its way to the phone.
+	MessageAccepted  MessageStatus = "a" // Accepted by the mobile operator.
+	MessageBuffered  MessageStatus = "b" // Queued within the mobile operator's network.
+	MessageDelivered MessageStatus = "d" // Delivered to the phone.
+	MessageFailed    MessageStatus = "f" // Could not be delivered.
+	MessageRejected  MessageStatus = "j" // Rejected by the mobile operator.
+	MessageUnknown   MessageStatus = "u" // Status unknown.
+)
+
+var messageStatusNames = map[MessageStatus]string{
+	MessageQueued:    "queued",
+	MessageSent:      "sent",
+	MessageSendError: "send error",
+	MessageEnroute:   "enroute",
+	MessageAccepted:  "accepted",
+	MessageBuffered:  "buffered",
+	MessageDelivered: "delivered",
+	MessageFailed:    "failed",
+	MessageRejected:  "rejected",
+	MessageUnknown:   "unknown",
+}
+
+// String returns the name of the status, such as "delivered".
+func (s MessageStatus) String() string {
+	return statusName(messageStatusNames, s)
+}
+
+// IsFinal reports whether the status will not change anymore.
+func (s MessageStatus) IsFinal() bool {
+	switch s {
+	case MessageDelivered, MessageSendError, MessageFailed, MessageRejected:
+		return true
+	}
+
+	return false
+}
+
+// IsSuccess reports whether the message was delivered.
+func (s MessageStatus) IsSuccess() bool {
+	return s == MessageDelivered
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface,
+// accepting both status codes and names.
+func (s *MessageStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalStatus(messageStatusNames, b, s)
+}
+
+// BulkStatus represents the status of a bulk session.
+// Values unknown to this package are kept as received.
+type BulkStatus string
+
+// Bulk session statuses.
+const (
+	BulkNew        BulkStatus = "n" // Created, not processed yet.
+	BulkProcessing BulkStatus = "p" // Messages are being created.
+	BulkCompleted  BulkStatus = "c" // All messages were created.
+	BulkFailed     BulkStatus = "f" // Processing failed.
+	BulkSuspended  BulkStatus = "s" // Processing was suspended.
+)
+
+var bulkStatusNames = map[BulkStatus]string{
+	BulkNew:        "new",
+	BulkProcessing: "processing",
+	BulkCompleted:  "completed",
+	BulkFailed:     "failed",
+	BulkSuspended:  "suspended",
+}
+
+// String returns the name of the status, such as "completed".
+func (s BulkStatus) String() string {
+	return statusName(bulkStatusNames, s)
+}
+
+// IsFinal reports whether the bulk session is done processing.
+func (s BulkStatus) IsFinal() bool {
+	return s == BulkCompleted || s == BulkFailed
+}
+
+// IsSuccess reports whether the bulk session completed.
+func (s BulkStatus) IsSuccess() bool {
+	return s == BulkCompleted
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface,
+// accepting both status codes and names.
+func (s *BulkStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalStatus(bulkStatusNames, b, s)
+}
+
+// NumberStatus represents the status of a dedicated number.
+// Values unknown to this package are kept as received.
+type NumberStatus string
+
+// Dedicated number statuses.
+const (
+	NumberActive   NumberStatus = "A"
+	NumberInactive NumberStatus = "I"
+)
+
+var numberStatusNames = map[NumberStatus]string{
+	NumberActive:   "active",
+	NumberInactive: "inactive",
+}
+
+// String returns the name of the status, such as "active".
+func (s NumberStatus) String() string {
+	return statusName(numberStatusNames, s)
+}
+
+// IsActive reports whether the number can be used.
+func (s NumberStatus) IsActive() bool {
+	return s == NumberActive
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface,
+// accepting both status codes and names.
+func (s *NumberStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalStatus(numberStatusNames, b, s)
+}
+
+// SenderIDStatus represents the status of a sender ID.
+// Values unknown to this package are kept as received.
+type SenderIDStatus string
+
+// Sender ID statuses.
+const (
+	SenderIDActive   SenderIDStatus = "A"
+	SenderIDPending  SenderIDStatus = "P"
+	SenderIDRejected SenderIDStatus = "R"
+)
+
+var senderIDStatusNames = map[SenderIDStatus]string{
+	SenderIDActive:   "active",
+	SenderIDPending:  "pending",
+	SenderIDRejected: "rejected",
+}
+
+// String returns the name of the status, such as "pending".
+func (s SenderIDStatus) String() string {
+	return statusName(senderIDStatusNames, s)
+}
+
+// IsFinal reports whether the sender ID has been reviewed.
+func (s SenderIDStatus) IsFinal() bool {
+	return s == SenderIDActive || s == SenderIDRejected
+}
+
+// IsSuccess reports whether the sender ID was approved.
+func (s SenderIDStatus) IsSuccess() bool {
+	return s == SenderIDActive
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface,
+// accepting both status codes and names.
+func (s *SenderIDStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalStatus(senderIDStatusNames, b, s)
+}
+
+// UserStatus represents the status of a user account.
+// Values unknown to this package are kept as received.
+type UserStatus string
+
+// User account statuses.
+const (
+	UserActive UserStatus = "A"
+	UserTrial  UserStatus = "T"
+)
+
+var userStatusNames = map[UserStatus]string{
+	UserActive: "active",
+	UserTrial:  "trial",
+}
+
+// String returns the name of the status, such as "trial".
+func (s UserStatus) String() string {
+	return statusName(userStatusNames, s)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface,
+// accepting both status codes and names.
+func (s *UserStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalStatus(userStatusNames, b, s)
+}
+
+// statusName returns the name of the status,
+// or the status itself when unknown.
+func statusName[S ~string](names map[S]string, s S) string {
+	if n, ok := names[s]; ok {
+		return n
+	}
+
+	return string(s)
+}
+
+// unmarshalStatus decodes a status code or name into s.
+func unmarshalStatus[S ~string](names map[S]string, b []byte, s *S) error {
+	var v string
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	*s = S(v)
+
+	for code, n := range names {
+		if strings.EqualFold(v, n) {
+			*s = code
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package textmagic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatus(t *testing.T) {
+	var m Message
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"status":"d"}`), &m))
+	assert.Equal(t, MessageDelivered, m.Status)
+	assert.Equal(t, "delivered", m.Status.String())
+	assert.True(t, m.Status.IsFinal())
+	assert.True(t, m.Status.IsSuccess())
+
+	// Names are accepted, unknown values kept
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"status":"Rejected"}`), &m))
+	assert.Equal(t, MessageRejected, m.Status)
+	assert.True(t, m.Status.IsFinal())
+	assert.False(t, m.Status.IsSuccess())
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"status":"x"}`), &m))
+	assert.Equal(t, MessageStatus("x"), m.Status)
+	assert.Equal(t, "x", m.Status.String())
+	assert.False(t, m.Status.IsFinal())
+
+	b, err := json.Marshal(MessageBuffered)
+
+	assert.Nil(t, err)
+	assert.Equal(t, `"b"`, string(b))
+
+	// Other statuses
+
+	assert.False(t, BulkProcessing.IsFinal())
+	assert.True(t, BulkCompleted.IsSuccess())
+	assert.True(t, NumberActive.IsActive())
+	assert.False(t, SenderIDPending.IsFinal())
+	assert.Equal(t, "trial", UserTrial.String())
+}
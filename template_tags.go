@@ -0,0 +1,270 @@
+package textmagic
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// contactTags lists the built-in template tags and
+// the contact values they are replaced with.
+var contactTags = []struct {
+	name  string
+	value func(*Contact) string
+}{
+	{"First name", func(c *Contact) string { return c.FirstName }},
+	{"Last name", func(c *Contact) string { return c.LastName }},
+	{"Company name", func(c *Contact) string { return c.Company }},
+	{"Company", func(c *Contact) string { return c.Company }},
+	{"Phone", func(c *Contact) string { return c.Phone }},
+	{"Country", func(c *Contact) string { return c.Country["name"] }},
+}
+
+// TemplateTag represents a tag inside braces in template content.
+type TemplateTag struct {
+	Name   string // Tag name, without braces.
+	Offset int    // Byte offset of the opening brace.
+}
+
+// RenderedTemplate represents template content rendered
+// for a contact.
+type RenderedTemplate struct {
+	Text       string    // Final message text.
+	Unresolved []string  // Tags matching no contact field, left as is.
+	Segments   *Segments // Encoding and parts of Text.
+}
+
+// ParseTemplateTags returns the tags in the given template
+// content, and the byte offsets of unbalanced braces, which
+// are not part of any tag.
+func ParseTemplateTags(content string) (tags []TemplateTag, unbalanced []int) {
+	open := -1
+
+	for i := 0; i < len(content); i++ {
+		switch content[i] {
+		case '{':
+			if open >= 0 {
+				unbalanced = append(unbalanced, open)
+			}
+
+			open = i
+
+		case '}':
+			if open < 0 {
+				unbalanced = append(unbalanced, i)
+			} else {
+				tags = append(tags, TemplateTag{content[open+1 : i], open})
+				open = -1
+			}
+		}
+	}
+
+	if open >= 0 {
+		unbalanced = append(unbalanced, open)
+	}
+
+	return tags, unbalanced
+}
+
+// Render replaces the tags of the template with the values of
+// the given contact, producing the final message text. Tags are
+// matched case-insensitively against the built-in contact fields,
+// {First name}, {Last name}, {Company name}, {Phone} and
+// {Country}, and the names of the contact's custom fields.
+// A nil contact leaves every tag unresolved.
+func (t *Template) Render(c *Contact) *RenderedTemplate {
+	var (
+		b    strings.Builder
+		r    = &RenderedTemplate{}
+		last = 0
+	)
+
+	tags, _ := ParseTemplateTags(t.Content)
+
+	for _, tag := range tags {
+		end := tag.Offset + len(tag.Name) + 2
+
+		v, ok := c.tagValue(tag.Name)
+
+		if !ok {
+			r.Unresolved = append(r.Unresolved, tag.Name)
+
+			continue
+		}
+
+		b.WriteString(t.Content[last:tag.Offset])
+		b.WriteString(v)
+		last = end
+	}
+
+	b.WriteString(t.Content[last:])
+
+	r.Text = b.String()
+	r.Segments = CountSegments(r.Text)
+
+	return r
+}
+
+// tagValue returns the value of the contact
+// field for the given template tag.
+func (c *Contact) tagValue(tag string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+
+	name := normalizeTag(tag)
+
+	for _, t := range contactTags {
+		if normalizeTag(t.name) == name {
+			return t.value(c), true
+		}
+	}
+
+	for _, f := range c.CustomFields {
+		if f != nil && normalizeTag(f.Name) == name {
+			return f.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// normalizeTag folds case and surrounding
+// space for matching tag names.
+func normalizeTag(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
+
+// UnknownTag represents a template tag matching no contact field.
+type UnknownTag struct {
+	TemplateTag
+	Suggestion string // Closest known tag, if any is close enough.
+}
+
+// TemplateReport represents the result of validating
+// template content.
+type TemplateReport struct {
+	UnknownTags []UnknownTag // Tags matching no contact field.
+	Unbalanced  []int        // Byte offsets of unbalanced braces.
+	Segments    *Segments    // Parts estimated with the tags as written.
+}
+
+// Err returns the problems found as ValidationErrors
+// for the content field, or nil if there are none.
+func (r *TemplateReport) Err() error {
+	v := ValidationErrors{}
+
+	for _, t := range r.UnknownTags {
+		m := "unknown tag {" + t.Name + "}"
+
+		if t.Suggestion != "" {
+			m += ", did you mean {" + t.Suggestion + "}?"
+		}
+
+		v.Add("content", m)
+	}
+
+	for _, i := range r.Unbalanced {
+		v.Add("content", "unbalanced brace at offset "+strconv.Itoa(i))
+	}
+
+	if len(v) > 0 {
+		return v
+	}
+
+	return nil
+}
+
+// ValidateTemplate checks template content for unbalanced braces
+// and for tags matching neither the built-in contact fields nor
+// the given custom fields, and estimates its message parts.
+func ValidateTemplate(content string, fields []*CustomField) *TemplateReport {
+	var known []string
+
+	for _, t := range contactTags {
+		known = append(known, t.name)
+	}
+
+	for _, f := range fields {
+		if f != nil {
+			known = append(known, f.Name)
+		}
+	}
+
+	r := &TemplateReport{Segments: CountSegments(content)}
+
+	var tags []TemplateTag
+
+	tags, r.Unbalanced = ParseTemplateTags(content)
+
+tags:
+	for _, tag := range tags {
+		name := normalizeTag(tag.Name)
+
+		for _, k := range known {
+			if normalizeTag(k) == name {
+				continue tags
+			}
+		}
+
+		u := UnknownTag{TemplateTag: tag}
+		best := 3 // Suggest tags at most two edits away
+
+		for _, k := range known {
+			if d := editDistance(name, normalizeTag(k)); d < best {
+				u.Suggestion, best = k, d
+			}
+		}
+
+		r.UnknownTags = append(r.UnknownTags, u)
+	}
+
+	return r
+}
+
+// ValidateTemplate checks template content against the account's
+// custom fields before it is passed to CreateTemplate or
+// UpdateTemplate. See the ValidateTemplate function.
+func (c *Client) ValidateTemplate(content string) (*TemplateReport, error) {
+	return c.ValidateTemplateContext(context.Background(), content)
+}
+
+// ValidateTemplateContext is the context-aware form of ValidateTemplate.
+func (c *Client) ValidateTemplateContext(ctx context.Context, content string) (*TemplateReport, error) {
+	fields, err := CollectAll(c.AllCustomFields(ctx, nil))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ValidateTemplate(content, fields), nil
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	row := make([]int, len(t)+1)
+
+	for j := range row {
+		row[j] = j
+	}
+
+	for i := 1; i <= len(s); i++ {
+		prev := row[0]
+		row[0] = i
+
+		for j := 1; j <= len(t); j++ {
+			cur := row[j]
+
+			if s[i-1] == t[j-1] {
+				row[j] = prev
+			} else {
+				row[j] = 1 + min(prev, row[j], row[j-1])
+			}
+
+			prev = cur
+		}
+	}
+
+	return row[len(t)]
+}
@@ -1,6 +1,10 @@
 package textmagic
 
-import "strconv"
+import (
+	"context"
+	"iter"
+	"strconv"
+)
 
 const templateURI = "templates"
 
@@ -15,23 +19,60 @@ type Template struct {
 	ID           int    `json:"id"`
 	Name         string `json:"name"`
 	Content      string `json:"content"`
-	LastModified string `json:"lastModified"`
+	LastModified Time   `json:"lastModified"`
 }
 
 // TemplateList represents a template list.
-type TemplateList struct {
-	Page      int         `json:"page"`
-	Limit     int         `json:"limit"`
-	PageCount int         `json:"pageCount"`
-	Resources []*Template `json:"resources"`
+type TemplateList = Page[*Template]
+
+// TemplateInput represents the data of a template, as
+// used by CreateTemplateFrom and UpdateTemplateFrom.
+type TemplateInput struct {
+	Name    string `json:"name"`    // Template name. Required.
+	Content string `json:"content"` // Template text. May contain tags inside braces. Required.
+}
+
+// Validate checks the input for missing
+// fields, returning ValidationErrors.
+func (in *TemplateInput) Validate() error {
+	v := ValidationErrors{}
+
+	if in.Name == "" {
+		v.Add("name", "name is required")
+	}
+
+	if in.Content == "" {
+		v.Add("content", "content is required")
+	}
+
+	if len(v) > 0 {
+		return v
+	}
+
+	return nil
+}
+
+// Params validates the input and encodes it
+// into the CreateTemplate data payload.
+func (in *TemplateInput) Params() (Params, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
+	return Params{"name": in.Name, "content": in.Content}, nil
 }
 
 // GetTemplate returns a single message template
 // for the given ID.
 func (c *Client) GetTemplate(id int) (*Template, error) {
+	return c.GetTemplateContext(context.Background(), id)
+}
+
+// GetTemplateContext is the context-aware form of GetTemplate.
+func (c *Client) GetTemplateContext(ctx context.Context, id int) (*Template, error) {
 	var t *Template
 
-	return t, c.get(templateURI+"/"+strconv.Itoa(id), nil, nil, &t)
+	return t, c.get(ctx, templateURI+"/"+strconv.Itoa(id), nil, nil, &t)
 }
 
 // CreateTemplate creates a new template.
@@ -40,9 +81,33 @@ func (c *Client) GetTemplate(id int) (*Template, error) {
 // - name:		Template name. Required.
 // - content:	Template text. May contain tags inside braces. Required.
 func (c *Client) CreateTemplate(d Params) (*NewTemplate, error) {
+	return c.CreateTemplateContext(context.Background(), d)
+}
+
+// CreateTemplateContext is the context-aware form of CreateTemplate.
+func (c *Client) CreateTemplateContext(ctx context.Context, d Params) (*NewTemplate, error) {
+	var t *NewTemplate
+
+	return t, c.post(ctx, templateURI, nil, d, &t)
+}
+
+// CreateTemplateFrom is like CreateTemplate, but takes a typed
+// input which is validated before it is sent.
+func (c *Client) CreateTemplateFrom(in *TemplateInput) (*NewTemplate, error) {
+	return c.CreateTemplateFromContext(context.Background(), in)
+}
+
+// CreateTemplateFromContext is the context-aware form of CreateTemplateFrom.
+func (c *Client) CreateTemplateFromContext(ctx context.Context, in *TemplateInput) (*NewTemplate, error) {
+	d, err := in.Params()
+
+	if err != nil {
+		return nil, err
+	}
+
 	var t *NewTemplate
 
-	return t, c.post(templateURI, nil, d, &t)
+	return t, c.request(ctx, "POST", templateURI, nil, d, in, &t)
 }
 
 // GetTemplateList returns all user message templates.
@@ -51,9 +116,21 @@ func (c *Client) CreateTemplate(d Params) (*NewTemplate, error) {
 // - page:	Fetch specified results page.
 // - limit:	How many results on page.
 func (c *Client) GetTemplateList(p Params, search bool) (*TemplateList, error) {
+	return c.GetTemplateListContext(context.Background(), p, search)
+}
+
+// GetTemplateListContext is the context-aware form of GetTemplateList.
+func (c *Client) GetTemplateListContext(ctx context.Context, p Params, search bool) (*TemplateList, error) {
 	var l *TemplateList
 
-	return l, c.get(templateURI, p, nil, &l)
+	return l, c.get(ctx, templateURI, p, nil, &l)
+}
+
+// AllTemplates returns an iterator over all user message templates.
+func (c *Client) AllTemplates(ctx context.Context, p Params) iter.Seq2[*Template, error] {
+	return Paginate(ctx, p, func(ctx context.Context, p Params) (*Page[*Template], error) {
+		return c.GetTemplateListContext(ctx, p, false)
+	})
 }
 
 // SearchTemplateList returns all user message templates
@@ -65,9 +142,20 @@ func (c *Client) GetTemplateList(p Params, search bool) (*TemplateList, error) {
 // - name: 		Find template by name.
 // - content:	Find template by content.
 func (c *Client) SearchTemplateList(p Params) (*TemplateList, error) {
+	return c.SearchTemplateListContext(context.Background(), p)
+}
+
+// SearchTemplateListContext is the context-aware form of SearchTemplateList.
+func (c *Client) SearchTemplateListContext(ctx context.Context, p Params) (*TemplateList, error) {
 	var l *TemplateList
 
-	return l, c.get(templateURI+"/search", p, nil, &l)
+	return l, c.get(ctx, templateURI+"/search", p, nil, &l)
+}
+
+// SearchAllTemplates returns an iterator over all user message
+// templates for the given search.
+func (c *Client) SearchAllTemplates(ctx context.Context, p Params) iter.Seq2[*Template, error] {
+	return Paginate(ctx, p, c.SearchTemplateListContext)
 }
 
 // UpdateTemplate updates the template with
@@ -77,13 +165,42 @@ func (c *Client) SearchTemplateList(p Params) (*TemplateList, error) {
 // - name:		Template name. Required.
 // - content:	Template text. May contain tags inside braces. Required.
 func (c *Client) UpdateTemplate(id int, d Params) (*NewTemplate, error) {
+	return c.UpdateTemplateContext(context.Background(), id, d)
+}
+
+// UpdateTemplateContext is the context-aware form of UpdateTemplate.
+func (c *Client) UpdateTemplateContext(ctx context.Context, id int, d Params) (*NewTemplate, error) {
 	var t *NewTemplate
 
-	return t, c.put(templateURI+"/"+strconv.Itoa(id), nil, d, &t)
+	return t, c.put(ctx, templateURI+"/"+strconv.Itoa(id), nil, d, &t)
+}
+
+// UpdateTemplateFrom is like UpdateTemplate, but takes a typed
+// input which is validated before it is sent.
+func (c *Client) UpdateTemplateFrom(id int, in *TemplateInput) (*NewTemplate, error) {
+	return c.UpdateTemplateFromContext(context.Background(), id, in)
+}
+
+// UpdateTemplateFromContext is the context-aware form of UpdateTemplateFrom.
+func (c *Client) UpdateTemplateFromContext(ctx context.Context, id int, in *TemplateInput) (*NewTemplate, error) {
+	d, err := in.Params()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var t *NewTemplate
+
+	return t, c.request(ctx, "PUT", templateURI+"/"+strconv.Itoa(id), nil, d, in, &t)
 }
 
 // DeleteTemplate deletes the template with
 // the given ID.
 func (c *Client) DeleteTemplate(id int) error {
-	return c.delete(templateURI+"/"+strconv.Itoa(id), nil, nil, nil)
+	return c.DeleteTemplateContext(context.Background(), id)
+}
+
+// DeleteTemplateContext is the context-aware form of DeleteTemplate.
+func (c *Client) DeleteTemplateContext(ctx context.Context, id int) error {
+	return c.delete(ctx, templateURI+"/"+strconv.Itoa(id), nil, nil, nil)
 }
@@ -67,3 +67,48 @@ func TestTemplates(t *testing.T) {
 
 	assert.Nil(t, err)
 }
+
+func TestTemplateRender(t *testing.T) {
+	template := &Template{Content: "Hi {First name} {last NAME}, your {Tier} code is {Code}. {Frist Name} {"}
+	contact := &Contact{
+		FirstName: "John",
+		LastName:  "Smith",
+		CustomFields: []*ContactCustomField{
+			{Name: "Tier", Value: "gold"},
+		},
+	}
+
+	r := template.Render(contact)
+
+	assert.Equal(t, "Hi John Smith, your gold code is {Code}. {Frist Name} {", r.Text)
+	assert.Equal(t, []string{"Code", "Frist Name"}, r.Unresolved)
+	assert.Equal(t, 1, r.Segments.Parts)
+
+	r = template.Render(nil)
+
+	assert.Equal(t, template.Content, r.Text)
+	assert.Equal(t, []string{"First name", "last NAME", "Tier", "Code", "Frist Name"}, r.Unresolved)
+
+	tags, unbalanced := ParseTemplateTags("{a} }{b{c}")
+
+	assert.Equal(t, []TemplateTag{{"a", 0}, {"c", 7}}, tags)
+	assert.Equal(t, []int{4, 5}, unbalanced)
+}
+
+func TestValidateTemplate(t *testing.T) {
+	r := ValidateTemplate("Hi {Frist Name}, {tier} {Code} {", []*CustomField{{Name: "Tier"}})
+
+	assert.Equal(t, []UnknownTag{
+		{TemplateTag{"Frist Name", 3}, "First name"},
+		{TemplateTag{"Code", 24}, ""},
+	}, r.UnknownTags)
+	assert.Equal(t, []int{31}, r.Unbalanced)
+	assert.Equal(t, 1, r.Segments.Parts)
+	assert.Equal(t, ValidationErrors{"content": {
+		"unknown tag {Frist Name}, did you mean {First name}?",
+		"unknown tag {Code}",
+		"unbalanced brace at offset 31",
+	}}, r.Err())
+
+	assert.Nil(t, ValidateTemplate("Hi {First name}", nil).Err())
+}
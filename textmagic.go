@@ -3,6 +3,7 @@ package textmagic
 import (
 	"bytes"
 	"strconv"
+	"unicode/utf8"
 )
 
 func joinIntSlice(v []int) string {
@@ -18,3 +19,23 @@ func joinIntSlice(v []int) string {
 
 	return buf.String()
 }
+
+func makeSet(v ...string) map[string]struct{} {
+	m := make(map[string]struct{}, len(v))
+
+	for _, s := range v {
+		m[s] = struct{}{}
+	}
+
+	return m
+}
+
+func runeSet(s string) map[rune]struct{} {
+	m := make(map[rune]struct{}, utf8.RuneCountInString(s))
+
+	for _, r := range s {
+		m[r] = struct{}{}
+	}
+
+	return m
+}
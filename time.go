@@ -0,0 +1,126 @@
+package textmagic
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// timeLayouts lists the timestamp formats used by the API.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05-0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// Time represents a timestamp returned by the API. It decodes the
+// API's ISO-8601 formats and Unix timestamps, and marshals back
+// exactly as received unless the time was changed since.
+//
+// Timestamps without a UTC offset are decoded as UTC. Use InZone
+// to read them in the account timezone returned by GetUser.
+type Time struct {
+	time.Time
+	layout string
+	raw    string // JSON value decoded
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *Time) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*t = Time{}
+
+		return nil
+	}
+
+	var s string
+
+	if err := json.Unmarshal(b, &s); err != nil {
+		// Unix timestamp
+		n, err := strconv.ParseInt(string(b), 10, 64)
+
+		if err != nil {
+			return fmt.Errorf("textmagic: invalid time %s", b)
+		}
+
+		*t = Time{time.Unix(n, 0).UTC(), "", string(b)}
+
+		return nil
+	}
+
+	if s == "" {
+		*t = Time{}
+
+		return nil
+	}
+
+	for _, l := range timeLayouts {
+		if v, err := time.Parse(l, s); err == nil {
+			*t = Time{v, l, string(b)}
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("textmagic: invalid time %q", s)
+}
+
+// MarshalJSON implements the json.Marshaler interface. Decoded
+// timestamps are returned as they were received, changed ones are
+// formatted in the layout decoded, and others as RFC 3339.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	if t.raw != "" {
+		var o Time
+
+		if o.UnmarshalJSON([]byte(t.raw)) == nil && o.Equal(t.Time) && o.Location().String() == t.Location().String() {
+			return []byte(t.raw), nil
+		}
+	}
+
+	l := t.layout
+
+	if l == "" {
+		l = time.RFC3339
+	}
+
+	return json.Marshal(t.Format(l))
+}
+
+// InZone returns the time in the given account timezone.
+// Timestamps received without a UTC offset are taken to be
+// in that timezone already, keeping their clock reading.
+func (t Time) InZone(tz *Timezone) time.Time {
+	loc := tz.Location()
+
+	switch t.layout {
+	case "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02":
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+	}
+
+	return t.In(loc)
+}
+
+// Location returns the time.Location of the timezone, falling
+// back to a fixed zone with its offset when the timezone name
+// is unknown to the system. A nil Timezone is UTC.
+func (tz *Timezone) Location() *time.Location {
+	if tz == nil {
+		return time.UTC
+	}
+
+	if tz.Timezone != "" {
+		if loc, err := time.LoadLocation(tz.Timezone); err == nil {
+			return loc
+		}
+	}
+
+	return time.FixedZone(tz.Timezone, tz.Offset)
+}
@@ -0,0 +1,66 @@
+package textmagic
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTime(t *testing.T) {
+	for _, s := range []string{
+		`"2016-03-24T14:22:31+0000"`,
+		`"2016-03-24T14:22:31+02:00"`,
+		`"2016-03-24T14:22:31"`,
+		`"2016-03-24 14:22:31"`,
+		`"2016-03-24"`,
+		`"2016-03-24T14:22:31.000Z"`,
+		`1458829351`,
+	} {
+		var v Time
+
+		assert.Nil(t, json.Unmarshal([]byte(s), &v), s)
+		assert.Equal(t, 2016, v.Year())
+		assert.Equal(t, time.March, v.Month())
+		assert.Equal(t, 24, v.Day())
+
+		b, err := json.Marshal(v)
+
+		assert.Nil(t, err)
+		assert.Equal(t, s, string(b))
+	}
+
+	// Changed values use the layout decoded
+
+	var v Time
+
+	assert.Nil(t, json.Unmarshal([]byte(`"2016-03-24T14:22:31.000Z"`), &v))
+
+	v.Time = v.Add(time.Second)
+	b, _ := json.Marshal(v)
+
+	assert.Equal(t, `"2016-03-24T14:22:32Z"`, string(b))
+
+	// Empty values
+
+	var m Message
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"messageTime":null}`), &m))
+	assert.True(t, m.MessageTime.IsZero())
+	assert.Nil(t, json.Unmarshal([]byte(`{"messageTime":""}`), &m))
+	assert.True(t, m.MessageTime.IsZero())
+	assert.NotNil(t, json.Unmarshal([]byte(`{"messageTime":"yesterday"}`), &m))
+
+	// Account timezone
+
+	tz := &Timezone{Timezone: "Europe/Riga", Offset: 7200}
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"messageTime":"2016-03-24T14:22:31"}`), &m))
+	assert.Equal(t, "2016-03-24T14:22:31+02:00", m.MessageTime.InZone(tz).Format(time.RFC3339))
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"messageTime":"2016-03-24T14:22:31+0000"}`), &m))
+	assert.Equal(t, "2016-03-24T16:22:31+02:00", m.MessageTime.InZone(tz).Format(time.RFC3339))
+
+	assert.Equal(t, "UTC+3", (&Timezone{Timezone: "UTC+3", Offset: 10800}).Location().String())
+}
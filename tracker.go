@@ -0,0 +1,246 @@
+package textmagic
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// StatusEvent represents a delivery status transition
+// of a message followed by a Tracker.
+type StatusEvent struct {
+	Message  *Message      // Message as last fetched, or with only ID set if never seen.
+	Previous MessageStatus // Status before the transition, empty for the first one.
+	TimedOut bool          // The message did not reach a final status within the timeout.
+}
+
+// TrackerOptions configures a Tracker.
+type TrackerOptions struct {
+	Interval  time.Duration // Delay between polls. Default is 5s.
+	Timeout   time.Duration // Time for a message to reach a final status. Default is no timeout.
+	BatchSize int           // Message IDs per search request. Default is 100.
+
+	// OnEvent, if set, is called for each status transition.
+	OnEvent func(StatusEvent)
+
+	// Events, if set, receives each status transition. Sends block
+	// until received or the context is done, and the channel is not
+	// closed by the Tracker.
+	Events chan<- StatusEvent
+}
+
+// Tracker follows the delivery status of outbound messages,
+// polling SearchMessageList with batched message IDs. Messages
+// stop being tracked once they reach a final status or time out.
+//
+// A Tracker is safe for concurrent use.
+type Tracker struct {
+	client *Client
+	opts   TrackerOptions
+
+	mu      sync.Mutex
+	pending map[int]*trackedMessage
+}
+
+type trackedMessage struct {
+	message  *Message
+	deadline time.Time
+}
+
+// NewTracker returns a new Tracker for the client.
+// opts may be nil to use the defaults.
+func (c *Client) NewTracker(opts *TrackerOptions) *Tracker {
+	t := &Tracker{
+		client:  c,
+		pending: map[int]*trackedMessage{},
+	}
+
+	if opts != nil {
+		t.opts = *opts
+	}
+
+	if t.opts.Interval <= 0 {
+		t.opts.Interval = 5 * time.Second
+	}
+
+	if t.opts.BatchSize <= 0 {
+		t.opts.BatchSize = 100
+	}
+
+	return t
+}
+
+// Track adds the messages with the given IDs to the tracker.
+// The timeout of each message starts when it is added.
+func (t *Tracker) Track(ids ...int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, id := range ids {
+		if _, ok := t.pending[id]; ok {
+			continue
+		}
+
+		m := &trackedMessage{message: &Message{ID: id}}
+
+		if t.opts.Timeout > 0 {
+			m.deadline = time.Now().Add(t.opts.Timeout)
+		}
+
+		t.pending[id] = m
+	}
+}
+
+// TrackSession adds all messages of the session
+// with the given ID to the tracker.
+func (t *Tracker) TrackSession(ctx context.Context, id int) error {
+	for m, err := range t.client.AllSessionMessages(ctx, id, nil) {
+		if err != nil {
+			return err
+		}
+
+		t.Track(m.ID)
+	}
+
+	return nil
+}
+
+// Pending returns the number of messages being tracked.
+func (t *Tracker) Pending() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return len(t.pending)
+}
+
+// Run polls the status of the tracked messages until ctx is done
+// or a request fails, reporting transitions through the OnEvent
+// callback and Events channel. Messages may be added while running.
+func (t *Tracker) Run(ctx context.Context) error {
+	for {
+		if err := t.Poll(ctx); err != nil {
+			return err
+		}
+
+		if err := sleep(ctx, t.opts.Interval); err != nil {
+			return err
+		}
+	}
+}
+
+// Poll fetches the status of the tracked messages once,
+// reporting transitions and timeouts.
+func (t *Tracker) Poll(ctx context.Context) error {
+	ids := t.ids()
+
+	for len(ids) > 0 {
+		batch := ids[:min(len(ids), t.opts.BatchSize)]
+		ids = ids[len(batch):]
+
+		l, err := t.client.SearchMessageListContext(ctx, Params{
+			"ids":   joinIntSlice(batch),
+			"limit": strconv.Itoa(len(batch)),
+		})
+
+		if err != nil {
+			return err
+		} else if l == nil {
+			continue
+		}
+
+		for _, m := range l.Resources {
+			if err = t.update(ctx, m); err != nil {
+				return err
+			}
+		}
+	}
+
+	return t.expire(ctx)
+}
+
+// ids returns the IDs of the tracked messages in order.
+func (t *Tracker) ids() []int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	ids := make([]int, 0, len(t.pending))
+
+	for id := range t.pending {
+		ids = append(ids, id)
+	}
+
+	sort.Ints(ids)
+
+	return ids
+}
+
+// update records the fetched message, emitting an
+// event when its status changed.
+func (t *Tracker) update(ctx context.Context, m *Message) error {
+	t.mu.Lock()
+
+	p, ok := t.pending[m.ID]
+
+	if !ok || p.message.Status == m.Status {
+		t.mu.Unlock()
+
+		return nil
+	}
+
+	e := StatusEvent{Message: m, Previous: p.message.Status}
+	p.message = m
+
+	if m.Status.IsFinal() {
+		delete(t.pending, m.ID)
+	}
+
+	t.mu.Unlock()
+
+	return t.emit(ctx, e)
+}
+
+// expire stops tracking messages past their deadline.
+func (t *Tracker) expire(ctx context.Context) error {
+	var events []StatusEvent
+
+	now := time.Now()
+
+	t.mu.Lock()
+
+	for id, p := range t.pending {
+		if !p.deadline.IsZero() && now.After(p.deadline) {
+			events = append(events, StatusEvent{Message: p.message, Previous: p.message.Status, TimedOut: true})
+			delete(t.pending, id)
+		}
+	}
+
+	t.mu.Unlock()
+
+	sort.Slice(events, func(i, j int) bool { return events[i].Message.ID < events[j].Message.ID })
+
+	for _, e := range events {
+		if err := t.emit(ctx, e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *Tracker) emit(ctx context.Context, e StatusEvent) error {
+	if t.opts.OnEvent != nil {
+		t.opts.OnEvent(e)
+	}
+
+	if t.opts.Events != nil {
+		select {
+		case t.opts.Events <- e:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package textmagic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracker(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		searches []string
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		searches = append(searches, r.URL.Query().Get("ids"))
+		n := len(searches)
+		mu.Unlock()
+
+		if r.URL.Path == "/sessions/5/messages" {
+			w.Write([]byte(`{"page":1,"pageCount":1,"resources":[{"id":3}]}`))
+
+			return
+		}
+
+		// Message 1 is delivered on the second poll, 2 fails
+		// right away and 3 stays queued.
+		var resources []string
+
+		for _, id := range strings.Split(r.URL.Query().Get("ids"), ",") {
+			status := "q"
+
+			switch {
+			case id == "1" && n > 2:
+				status = "d"
+
+			case id == "2":
+				status = "f"
+			}
+
+			resources = append(resources, fmt.Sprintf(`{"id":%s,"status":"%s"}`, id, status))
+		}
+
+		fmt.Fprintf(w, `{"page":1,"pageCount":1,"resources":[%s]}`, strings.Join(resources, ","))
+	}))
+	defer srv.Close()
+
+	c := NewClient("", "", WithBaseURL(srv.URL))
+
+	var events []string
+
+	tr := c.NewTracker(&TrackerOptions{
+		Interval:  time.Millisecond,
+		Timeout:   50 * time.Millisecond,
+		BatchSize: 2,
+		OnEvent: func(e StatusEvent) {
+			events = append(events, fmt.Sprintf("%d %s->%s %v", e.Message.ID, e.Previous, e.Message.Status, e.TimedOut))
+		},
+	})
+
+	tr.Track(1, 2)
+	assert.Nil(t, tr.TrackSession(context.Background(), 5))
+	assert.Equal(t, 3, tr.Pending())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for tr.Pending() > 0 && ctx.Err() == nil {
+		assert.Nil(t, tr.Poll(ctx))
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	assert.Equal(t, []string{"", "1,2", "3"}, searches[:3])
+	assert.Equal(t, []string{
+		"1 ->queued false",
+		"2 ->failed false",
+		"3 ->queued false",
+		"1 queued->delivered false",
+		"3 queued->queued true",
+	}, events)
+
+	// Run stops with the context
+
+	tr.Track(3)
+	cancel()
+
+	assert.ErrorIs(t, tr.Run(ctx), context.Canceled)
+
+	// Empty responses are skipped
+
+	empty := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer empty.Close()
+
+	tr = NewClient("", "", WithBaseURL(empty.URL)).NewTracker(nil)
+	tr.Track(1)
+
+	assert.Nil(t, tr.Poll(context.Background()))
+	assert.Equal(t, 1, tr.Pending())
+}
@@ -0,0 +1,76 @@
+package textmagic
+
+import "strings"
+
+// gsmTransliterations maps common characters outside the GSM-7
+// alphabet to GSM-7 equivalents which keep the text readable.
+var gsmTransliterations = map[rune]string{
+	// Quotes
+	'‘': "'", '’': "'", '‚': "'", '‛': "'", '′': "'", '‹': "'", '›': "'", '`': "'", '´': "'",
+	'“': `"`, '”': `"`, '„': `"`, '‟': `"`, '″': `"`, '«': `"`, '»': `"`,
+
+	// Dashes and punctuation
+	'‐': "-", '‑': "-", '‒': "-", '–': "-", '—': "-", '―': "-", '−': "-",
+	'…': "...", '•': "*", '·': ".",
+
+	// Spaces
+	'\t': " ", '\u00a0': " ", '\u2002': " ", '\u2003': " ", '\u2009': " ",
+	'\u200a': " ", '\u202f': " ", '\u200b': "", '\ufeff': "",
+
+	// Accented Latin letters
+	'á': "a", 'â': "a", 'ã': "a", 'ā': "a", 'ă': "a", 'ą': "a",
+	'Á': "A", 'À': "A", 'Â': "A", 'Ã': "A", 'Ā': "A", 'Ă': "A", 'Ą': "A",
+	'ç': "c", 'ć': "c", 'č': "c", 'Ć': "C", 'Č': "C",
+	'ď': "d", 'Ď': "D",
+	'ê': "e", 'ë': "e", 'ē': "e", 'ė': "e", 'ę': "e", 'ě': "e",
+	'È': "E", 'Ê': "E", 'Ë': "E", 'Ē': "E", 'Ė': "E", 'Ę': "E", 'Ě': "E",
+	'í': "i", 'î': "i", 'ï': "i", 'ī': "i", 'į': "i",
+	'Í': "I", 'Ì': "I", 'Î': "I", 'Ï': "I", 'Ī': "I", 'Į': "I",
+	'ł': "l", 'Ł': "L",
+	'ń': "n", 'ň': "n", 'Ń': "N", 'Ň': "N",
+	'ó': "o", 'ô': "o", 'õ': "o", 'ō': "o", 'ő': "o",
+	'Ó': "O", 'Ò': "O", 'Ô': "O", 'Õ': "O", 'Ō': "O", 'Ő': "O",
+	'œ': "oe", 'Œ': "OE",
+	'ř': "r", 'Ř': "R",
+	'ś': "s", 'š': "s", 'Ś': "S", 'Š': "S",
+	'ť': "t", 'Ť': "T",
+	'ú': "u", 'û': "u", 'ū': "u", 'ů': "u", 'ű': "u",
+	'Ú': "U", 'Ù': "U", 'Û': "U", 'Ū': "U", 'Ů': "U", 'Ű': "U",
+	'ý': "y", 'ÿ': "y", 'Ý': "Y", 'Ÿ': "Y",
+	'ź': "z", 'ż': "z", 'ž': "z", 'Ź': "Z", 'Ż': "Z", 'Ž': "Z",
+}
+
+// Replacement describes a character replaced by TransliterateGSM.
+type Replacement struct {
+	Offset int    // Byte offset of the character in the original text.
+	From   rune   // Replaced character.
+	To     string // GSM-7 replacement, possibly empty.
+}
+
+// TransliterateGSM replaces common characters outside the GSM-7
+// alphabet, such as smart quotes, dashes, ellipses and accented
+// Latin letters, with GSM-7 equivalents, so that pasted text does
+// not force the message into UCS-2. Characters with no safe
+// equivalent are kept. The replacements made are returned in
+// text order.
+func TransliterateGSM(text string) (string, []Replacement) {
+	var (
+		b   strings.Builder
+		rep []Replacement
+	)
+
+	for i, r := range text {
+		if t, ok := gsmTransliterations[r]; ok {
+			rep = append(rep, Replacement{i, r, t})
+			b.WriteString(t)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+
+	if len(rep) == 0 {
+		return text, nil
+	}
+
+	return b.String(), rep
+}
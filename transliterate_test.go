@@ -0,0 +1,52 @@
+package textmagic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransliterateGSM(t *testing.T) {
+	text, rep := TransliterateGSM("“Brûlée” – ok…")
+
+	assert.Equal(t, `"Brulée" - ok...`, text)
+	assert.Equal(t, []Replacement{
+		{0, '“', `"`},
+		{5, 'û', "u"},
+		{11, '”', `"`},
+		{15, '–', "-"},
+		{21, '…', "..."},
+	}, rep)
+	assert.Equal(t, GSM7, CountSegments(text).Encoding)
+
+	// é is part of GSM-7 already
+	text, rep = TransliterateGSM("é")
+
+	assert.Equal(t, "é", text)
+	assert.Nil(t, rep)
+
+	// Characters without equivalent are kept
+
+	text, _ = TransliterateGSM("Привет — мир")
+
+	assert.Equal(t, "Привет - мир", text)
+	assert.Equal(t, UCS2, CountSegments(text).Encoding)
+
+	// Opt-in per request
+
+	r := &MessageRequest{Text: "It’s", Phones: []string{"447860021130"}}
+	p, _ := r.Params()
+
+	assert.Equal(t, "It’s", p["text"])
+	assert.Nil(t, r.Replacements())
+
+	r.TransliterateGSM = true
+	p, _ = r.Params()
+
+	assert.Equal(t, "It's", p["text"])
+	assert.Equal(t, []Replacement{{2, '’', "'"}}, r.Replacements())
+
+	text, _ = TransliterateGSM("façade")
+
+	assert.Equal(t, "facade", text)
+}
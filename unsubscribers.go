@@ -1,6 +1,10 @@
 package textmagic
 
-import "strconv"
+import (
+	"context"
+	"iter"
+	"strconv"
+)
 
 const unsubscriberURI = "unsubscribers"
 
@@ -14,31 +18,36 @@ type NewUnsubscriber struct {
 type Unsubscriber struct {
 	ID              int    `json:"id"`
 	Phone           string `json:"phone"`
-	UnsubscribeTime string `json:"unsubscribeTime"`
+	UnsubscribeTime Time   `json:"unsubscribeTime"`
 	FirstName       string `json:"firstName"`
 	LastName        string `json:"lastName"`
 }
 
 // UnsubscriberList represents a unsubscriber list.
-type UnsubscriberList struct {
-	Page      int             `json:"page"`
-	Limit     int             `json:"limit"`
-	PageCount int             `json:"pageCount"`
-	Resources []*Unsubscriber `json:"resources"`
-}
+type UnsubscriberList = Page[*Unsubscriber]
 
 // GetUnsubscriber returns an unsubscribed contact by ID.
 func (c *Client) GetUnsubscriber(id int) (*Unsubscriber, error) {
+	return c.GetUnsubscriberContext(context.Background(), id)
+}
+
+// GetUnsubscriberContext is the context-aware form of GetUnsubscriber.
+func (c *Client) GetUnsubscriberContext(ctx context.Context, id int) (*Unsubscriber, error) {
 	var u *Unsubscriber
 
-	return u, c.get(unsubscriberURI+"/"+strconv.Itoa(id), nil, nil, &u)
+	return u, c.get(ctx, unsubscriberURI+"/"+strconv.Itoa(id), nil, nil, &u)
 }
 
 // UnsubscribePhone unsubscribes a contact by phone number.
 func (c *Client) UnsubscribePhone(phone string) (*NewUnsubscriber, error) {
+	return c.UnsubscribePhoneContext(context.Background(), phone)
+}
+
+// UnsubscribePhoneContext is the context-aware form of UnsubscribePhone.
+func (c *Client) UnsubscribePhoneContext(ctx context.Context, phone string) (*NewUnsubscriber, error) {
 	var u *NewUnsubscriber
 
-	return u, c.post(unsubscriberURI, nil, NewParams("phone", phone), &u)
+	return u, c.post(ctx, unsubscriberURI, nil, NewParams("phone", phone), &u)
 }
 
 // GetUnsubscriberList returns all contacts that
@@ -48,7 +57,18 @@ func (c *Client) UnsubscribePhone(phone string) (*NewUnsubscriber, error) {
 // - page:	Fetch specified results page.
 // - limit:	How many results on page.
 func (c *Client) GetUnsubscriberList(p Params) (*UnsubscriberList, error) {
+	return c.GetUnsubscriberListContext(context.Background(), p)
+}
+
+// GetUnsubscriberListContext is the context-aware form of GetUnsubscriberList.
+func (c *Client) GetUnsubscriberListContext(ctx context.Context, p Params) (*UnsubscriberList, error) {
 	var l *UnsubscriberList
 
-	return l, c.get(unsubscriberURI, p, nil, &l)
+	return l, c.get(ctx, unsubscriberURI, p, nil, &l)
+}
+
+// AllUnsubscribers returns an iterator over all contacts
+// that have unsubscribed from your communication.
+func (c *Client) AllUnsubscribers(ctx context.Context, p Params) iter.Seq2[*Unsubscriber, error] {
+	return Paginate(ctx, p, c.GetUnsubscriberListContext)
 }
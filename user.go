@@ -1,6 +1,11 @@
 package textmagic
 
-import "strconv"
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"strconv"
+)
 
 const (
 	statURI       = "stats"
@@ -12,9 +17,9 @@ const (
 // MessagingStat represents messaging statistics.
 type MessagingStat struct {
 	ReplyRate             float64 `json:"replyRate"`
-	Date                  string  `json:"date"`
+	Date                  Time    `json:"date"`
 	DeliveryRate          float64 `json:"deliveryRate"`
-	Costs                 float64 `json:"costs"`
+	Costs                 Money   `json:"costs"`
 	MessagesReceived      int     `json:"messagesReceived"`
 	MessagesSentDelivered int     `json:"messagesSentDelivered"`
 	MessagesSentAccepted  int     `json:"messagesSentAccepted"`
@@ -26,23 +31,18 @@ type MessagingStat struct {
 
 // SpendingStat represents spending statistics.
 type SpendingStat struct {
-	ID      int     `json:"id"`
-	UserID  int     `json:"userId"`
-	Date    string  `json:"date"`
-	Balance float64 `json:"balance"`
-	Delta   float64 `json:"delta"`
-	Type    string  `json:"type"`
-	Value   string  `json:"value"`
-	Comment string  `json:"comment"`
+	ID      int    `json:"id"`
+	UserID  int    `json:"userId"`
+	Date    Time   `json:"date"`
+	Balance Money  `json:"balance"`
+	Delta   Money  `json:"delta"`
+	Type    string `json:"type"`
+	Value   string `json:"value"`
+	Comment string `json:"comment"`
 }
 
 // SpendingStatList represents a spending statistics list.
-type SpendingStatList struct {
-	Page      int             `json:"page"`
-	Limit     int             `json:"limit"`
-	PageCount int             `json:"pageCount"`
-	Resources []*SpendingStat `json:"resources"`
-}
+type SpendingStatList = Page[*SpendingStat]
 
 // NewToken represents a new token.
 type NewToken struct {
@@ -68,26 +68,35 @@ type Timezone struct {
 
 // User represents a user.
 type User struct {
-	ID             int       `json:"id"`
-	Username       string    `json:"username"`
-	FirstName      string    `json:"firstName"`
-	LastName       string    `json:"lastName"`
-	Status         string    `json:"status"`
-	Balance        float64   `json:"balance"`
-	Company        string    `json:"company"`
-	Currency       *Currency `json:"currency"`
-	Timezone       *Timezone `json:"timezone"`
-	SubaccountType string    `json:"subaccountType"`
+	ID             int        `json:"id"`
+	Username       string     `json:"username"`
+	FirstName      string     `json:"firstName"`
+	LastName       string     `json:"lastName"`
+	Status         UserStatus `json:"status"`
+	Balance        Money      `json:"balance"`
+	Company        string     `json:"company"`
+	Currency       *Currency  `json:"currency"`
+	Timezone       *Timezone  `json:"timezone"`
+	SubaccountType string     `json:"subaccountType"`
 }
 
-// UserList represents a user list.
-type UserList struct {
-	Page      int     `json:"page"`
-	Limit     int     `json:"limit"`
-	PageCount int     `json:"pageCount"`
-	Resources []*User `json:"resources"`
+// UnmarshalJSON implements the json.Unmarshaler interface,
+// attaching the user's currency to its balance.
+func (u *User) UnmarshalJSON(b []byte) error {
+	type plain User
+
+	if err := json.Unmarshal(b, (*plain)(u)); err != nil {
+		return err
+	}
+
+	u.Balance.Currency = u.Currency
+
+	return nil
 }
 
+// UserList represents a user list.
+type UserList = Page[*User]
+
 // GetMessagingStat returns messaging statistics.
 //
 // The parameter payload includes:
@@ -95,9 +104,14 @@ type UserList struct {
 // - start:	Start date in Unix timestamp format. Default is 7 days ago.
 // - end:	End date in Unix timestamp format. Default is now.
 func (c *Client) GetMessagingStat(p Params) ([]*MessagingStat, error) {
+	return c.GetMessagingStatContext(context.Background(), p)
+}
+
+// GetMessagingStatContext is the context-aware form of GetMessagingStat.
+func (c *Client) GetMessagingStatContext(ctx context.Context, p Params) ([]*MessagingStat, error) {
 	var l []*MessagingStat
 
-	return l, c.get(statURI+"/messaging", p, nil, &l)
+	return l, c.get(ctx, statURI+"/messaging", p, nil, &l)
 }
 
 // GetSpendingStat returns account spending statistics.
@@ -108,16 +122,32 @@ func (c *Client) GetMessagingStat(p Params) ([]*MessagingStat, error) {
 // - start: Start date in Unix timestamp format. Default is 7 days ago.
 // - end:   End date in Unix timestamp format. Default is now.
 func (c *Client) GetSpendingStat(p Params) (*SpendingStatList, error) {
+	return c.GetSpendingStatContext(context.Background(), p)
+}
+
+// GetSpendingStatContext is the context-aware form of GetSpendingStat.
+func (c *Client) GetSpendingStatContext(ctx context.Context, p Params) (*SpendingStatList, error) {
 	var s *SpendingStatList
 
-	return s, c.get(statURI+"/spending", p, nil, &s)
+	return s, c.get(ctx, statURI+"/spending", p, nil, &s)
+}
+
+// AllSpendingStats returns an iterator over all account
+// spending statistics.
+func (c *Client) AllSpendingStats(ctx context.Context, p Params) iter.Seq2[*SpendingStat, error] {
+	return Paginate(ctx, p, c.GetSpendingStatContext)
 }
 
 // GetUser returns the current user.
 func (c *Client) GetUser() (*User, error) {
+	return c.GetUserContext(context.Background())
+}
+
+// GetUserContext is the context-aware form of GetUser.
+func (c *Client) GetUserContext(ctx context.Context) (*User, error) {
 	var u *User
 
-	return u, c.get(userURI, nil, nil, &u)
+	return u, c.get(ctx, userURI, nil, nil, &u)
 }
 
 // UpdateUser updates the current user.
@@ -127,16 +157,26 @@ func (c *Client) GetUser() (*User, error) {
 // - lastName:  User last name. Required.
 // - company:   User company. Required.
 func (c *Client) UpdateUser(d Params) (map[string]string, error) {
+	return c.UpdateUserContext(context.Background(), d)
+}
+
+// UpdateUserContext is the context-aware form of UpdateUser.
+func (c *Client) UpdateUserContext(ctx context.Context, d Params) (map[string]string, error) {
 	result := make(map[string]string)
 
-	return result, c.put(userURI, nil, d, &result)
+	return result, c.put(ctx, userURI, nil, d, &result)
 }
 
 // GetSubaccount gets a subaccount by the given ID.
 func (c *Client) GetSubaccount(id int) (*User, error) {
+	return c.GetSubaccountContext(context.Background(), id)
+}
+
+// GetSubaccountContext is the context-aware form of GetSubaccount.
+func (c *Client) GetSubaccountContext(ctx context.Context, id int) (*User, error) {
 	var u *User
 
-	return u, c.get(subAccountURI+"/"+strconv.Itoa(id), nil, nil, &u)
+	return u, c.get(ctx, subAccountURI+"/"+strconv.Itoa(id), nil, nil, &u)
 }
 
 // GetSubaccountList returns all user subaccounts.
@@ -145,9 +185,19 @@ func (c *Client) GetSubaccount(id int) (*User, error) {
 // - page:	Fetch specified results page.
 // - limit:	How many results on page.
 func (c *Client) GetSubaccountList(p Params) (*UserList, error) {
+	return c.GetSubaccountListContext(context.Background(), p)
+}
+
+// GetSubaccountListContext is the context-aware form of GetSubaccountList.
+func (c *Client) GetSubaccountListContext(ctx context.Context, p Params) (*UserList, error) {
 	var l *UserList
 
-	return l, c.get(subAccountURI, p, nil, &l)
+	return l, c.get(ctx, subAccountURI, p, nil, &l)
+}
+
+// AllSubaccounts returns an iterator over all user subaccounts.
+func (c *Client) AllSubaccounts(ctx context.Context, p Params) iter.Seq2[*User, error] {
+	return Paginate(ctx, p, c.GetSubaccountListContext)
 }
 
 // SendInvite sends an invite for a new subaccount.
@@ -156,10 +206,20 @@ func (c *Client) GetSubaccountList(p Params) (*UserList, error) {
 // - email: Subaccount email. Required.
 // - role:  Subaccount role: `A` for administrator or `U` for regular user. Required.
 func (c *Client) SendInvite(d Params) error {
-	return c.post(subAccountURI, nil, d, nil)
+	return c.SendInviteContext(context.Background(), d)
+}
+
+// SendInviteContext is the context-aware form of SendInvite.
+func (c *Client) SendInviteContext(ctx context.Context, d Params) error {
+	return c.post(ctx, subAccountURI, nil, d, nil)
 }
 
 // CloseSubaccount closes the subaccount for the given ID.
 func (c *Client) CloseSubaccount(id int) error {
-	return c.delete(subAccountURI+"/"+strconv.Itoa(id), nil, nil, nil)
+	return c.CloseSubaccountContext(context.Background(), id)
+}
+
+// CloseSubaccountContext is the context-aware form of CloseSubaccount.
+func (c *Client) CloseSubaccountContext(ctx context.Context, id int) error {
+	return c.delete(ctx, subAccountURI+"/"+strconv.Itoa(id), nil, nil, nil)
 }
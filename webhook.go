@@ -0,0 +1,231 @@
+package textmagic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const maxWebhookBody = 1 << 20 // Callback request bytes read
+
+// DeliveryEvent represents a delivery status callback
+// for an outbound message.
+type DeliveryEvent struct {
+	Message
+
+	SessionID  int  `json:"sessionId"`
+	StatusTime Time `json:"statusTime"`
+}
+
+// InboundEvent represents an inbound message callback.
+type InboundEvent struct {
+	Reply
+}
+
+// Webhook is an http.Handler for TextMagic callbacks. It parses
+// delivery status and inbound message callbacks, sent either as
+// form data or JSON, and dispatches them to the registered
+// handlers. Callbacks carrying a status are delivery events, all
+// others inbound messages.
+//
+// Handlers are called in registration order. When one returns an
+// error, the remaining ones are skipped and the callback is answered
+// with a 500 status, so that it is sent again.
+type Webhook struct {
+	mu       sync.RWMutex
+	delivery []func(context.Context, *DeliveryEvent) error
+	inbound  []func(context.Context, *InboundEvent) error
+}
+
+// NewWebhook returns a new Webhook without handlers.
+func NewWebhook() *Webhook {
+	return &Webhook{}
+}
+
+// OnDelivery registers a handler for delivery status callbacks.
+func (wh *Webhook) OnDelivery(f func(context.Context, *DeliveryEvent) error) {
+	wh.mu.Lock()
+	defer wh.mu.Unlock()
+
+	wh.delivery = append(wh.delivery, f)
+}
+
+// OnInbound registers a handler for inbound message callbacks.
+func (wh *Webhook) OnInbound(f func(context.Context, *InboundEvent) error) {
+	wh.mu.Lock()
+	defer wh.mu.Unlock()
+
+	wh.inbound = append(wh.inbound, f)
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (wh *Webhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	v, err := webhookValues(w, r)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	// Handlers run without the lock, so they may register others
+	wh.mu.RLock()
+	delivery, inbound := wh.delivery, wh.inbound
+	wh.mu.RUnlock()
+
+	if _, ok := v["status"]; ok {
+		e := &DeliveryEvent{}
+
+		if err = decodeValues(v, e); err == nil {
+			for _, f := range delivery {
+				if err = f(r.Context(), e); err != nil {
+					break
+				}
+			}
+		}
+	} else {
+		e := &InboundEvent{}
+
+		if err = decodeValues(v, e); err == nil {
+			for _, f := range inbound {
+				if err = f(r.Context(), e); err != nil {
+					break
+				}
+			}
+		}
+	}
+
+	var de *decodeError
+
+	switch {
+	case errors.As(err, &de):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+	case err != nil:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+
+	default:
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// webhookValues reads the callback payload as raw JSON values.
+// Form values are kept as strings, for decodeValues to convert.
+func webhookValues(w http.ResponseWriter, r *http.Request) (map[string]json.RawMessage, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxWebhookBody)
+
+	if t, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); t == "application/json" {
+		var v map[string]json.RawMessage
+
+		b, err := io.ReadAll(r.Body)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err = json.Unmarshal(b, &v); err != nil {
+			return nil, fmt.Errorf("textmagic: invalid callback payload: %v", err)
+		}
+
+		return v, nil
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+
+	return formValues(r.Form), nil
+}
+
+func formValues(f url.Values) map[string]json.RawMessage {
+	v := make(map[string]json.RawMessage, len(f))
+
+	for k := range f {
+		b, _ := json.Marshal(f.Get(k))
+		v[k] = b
+	}
+
+	return v
+}
+
+// decodeError is returned by decodeValues for payload values
+// which do not fit the event fields.
+type decodeError struct {
+	field string
+	err   error
+}
+
+func (e *decodeError) Error() string {
+	return fmt.Sprintf("textmagic: invalid callback field %q: %v", e.field, e.err)
+}
+
+// decodeValues sets the fields of the struct pointed to by dst,
+// including those of embedded structs, from the values named by
+// their JSON tags. Unlike json.Unmarshal, numbers and booleans
+// given as strings, as in form data, are accepted.
+func decodeValues(v map[string]json.RawMessage, dst interface{}) error {
+	r := reflect.ValueOf(dst).Elem()
+
+	for i := 0; i < r.NumField(); i++ {
+		f, sf := r.Field(i), r.Type().Field(i)
+
+		if sf.Anonymous {
+			if err := decodeValues(v, f.Addr().Interface()); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		b, ok := v[name]
+
+		if !ok || name == "" || name == "-" {
+			continue
+		}
+
+		if err := decodeValue(b, f.Addr().Interface()); err != nil {
+			return &decodeError{name, err}
+		}
+	}
+
+	return nil
+}
+
+// decodeValue decodes b into dst, retrying strings holding
+// a number or boolean as the unquoted value.
+func decodeValue(b json.RawMessage, dst interface{}) error {
+	err := json.Unmarshal(b, dst)
+
+	if err == nil {
+		return nil
+	}
+
+	var s string
+
+	if json.Unmarshal(b, &s) != nil {
+		return err
+	}
+
+	if _, perr := strconv.ParseFloat(s, 64); perr == nil || s == "true" || s == "false" {
+		return json.Unmarshal([]byte(s), dst)
+	}
+
+	return err
+}
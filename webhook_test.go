@@ -0,0 +1,108 @@
+package textmagic
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhook(t *testing.T) {
+	var (
+		deliveries []*DeliveryEvent
+		inbound    []*InboundEvent
+		fail       bool
+	)
+
+	wh := NewWebhook()
+
+	wh.OnDelivery(func(ctx context.Context, e *DeliveryEvent) error {
+		deliveries = append(deliveries, e)
+
+		return nil
+	})
+
+	wh.OnInbound(func(ctx context.Context, e *InboundEvent) error {
+		// Handlers may register others
+		if len(inbound) == 0 {
+			wh.OnDelivery(func(ctx context.Context, e *DeliveryEvent) error { return nil })
+		}
+
+		if fail {
+			return errors.New("unavailable")
+		}
+
+		inbound = append(inbound, e)
+
+		return nil
+	})
+
+	srv := httptest.NewServer(wh)
+	defer srv.Close()
+
+	post := func(contentType, body string) int {
+		resp, err := http.Post(srv.URL, contentType, strings.NewReader(body))
+
+		if !assert.Nil(t, err) {
+			return 0
+		}
+
+		resp.Body.Close()
+
+		return resp.StatusCode
+	}
+
+	// Delivery status as form data
+
+	form := url.Values{
+		"id":          {"49576009"},
+		"sessionId":   {"34436487"},
+		"receiver":    {"447860021130"},
+		"status":      {"d"},
+		"statusTime":  {"1500000000"},
+		"price":       {"0.04"},
+		"partsCount":  {"1"},
+		"messageTime": {"2017-07-14T02:40:00+0000"},
+	}
+
+	assert.Equal(t, http.StatusOK, post("application/x-www-form-urlencoded", form.Encode()))
+	assert.Equal(t, 1, len(deliveries))
+
+	d := deliveries[0]
+
+	assert.Equal(t, 49576009, d.ID)
+	assert.Equal(t, 34436487, d.SessionID)
+	assert.Equal(t, "447860021130", d.Receiver)
+	assert.Equal(t, MessageDelivered, d.Status)
+	assert.Equal(t, int64(1500000000), d.StatusTime.Unix())
+	assert.Equal(t, "0.04", d.Price.String())
+	assert.Equal(t, 1, d.PartsCount)
+	assert.Equal(t, int64(1500000000), d.MessageTime.Unix())
+
+	// Inbound message as JSON
+
+	assert.Equal(t, http.StatusOK, post("application/json", `{"id":7,"sender":"447860021130","receiver":"447520631612","text":"STOP","messageTime":"2017-07-14T02:40:00+0000"}`))
+	assert.Equal(t, 1, len(inbound))
+	assert.Equal(t, 7, inbound[0].ID)
+	assert.Equal(t, "STOP", inbound[0].Text)
+	assert.Equal(t, "447860021130", inbound[0].Sender)
+
+	// Invalid payloads and handler errors
+
+	assert.Equal(t, http.StatusBadRequest, post("application/json", `{"id":`))
+	assert.Equal(t, http.StatusBadRequest, post("application/x-www-form-urlencoded", "id=x&status=d"))
+
+	fail = true
+
+	assert.Equal(t, http.StatusInternalServerError, post("application/json", `{"id":8,"text":"Hi"}`))
+
+	resp, err := http.Get(srv.URL)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}